@@ -0,0 +1,94 @@
+package gqlgen_sqlboiler
+
+import (
+	"sort"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+// Authorizer is the generated e.g. PostAuthorizer interface, the resolvers of the model ask it whether the user may
+// create, read, update or delete and it adds its own query mods to reads. AllowAllPostAuthorizer is used by default.
+type Authorizer struct {
+	Model *Model
+	// CreateInput and UpdateInput are nil when the schema has no e.g. PostCreateInput, CanCreate and CanUpdate only
+	// get the context then
+	CreateInput *Model
+	UpdateInput *Model
+}
+
+// FieldName is the field of the resolver which holds the authorizer e.g. postAuthorizer
+func (a *Authorizer) FieldName() string {
+	return templates.LcFirst(a.Model.Name) + "Authorizer"
+}
+
+// AuthorizerCall returns the call of the authorizer method e.g. r.postAuthorizer.CanCreate(ctx, &input), the input is
+// left out when the method does not take one
+func (r *Resolver) AuthorizerCall(method string, input string) string {
+	if r.Authorizer == nil {
+		return ""
+	}
+	hasInput := (method == "CanCreate" && r.Authorizer.CreateInput != nil) ||
+		(method == "CanUpdate" && r.Authorizer.UpdateInput != nil) || method == "CanDelete"
+	if input == "" || !hasInput {
+		return "r." + r.Authorizer.FieldName() + "." + method + "(ctx)"
+	}
+	return "r." + r.Authorizer.FieldName() + "." + method + "(ctx, " + input + ")"
+}
+
+func getAuthorizer(models []*Model, modelName string) *Authorizer {
+	model := findModel(models, modelName)
+	if model == nil {
+		return nil
+	}
+	return &Authorizer{
+		Model:       model,
+		CreateInput: findModel(models, modelName+"CreateInput"),
+		UpdateInput: findModel(models, modelName+"UpdateInput"),
+	}
+}
+
+// getAuthorizers returns the authorizers of the generated resolvers sorted by model
+func getAuthorizers(resolvers []*Resolver) []*Authorizer {
+	byModel := map[string]*Authorizer{}
+	for _, r := range resolvers {
+		if r.Authorizer != nil && r.IsGenerated() {
+			byModel[r.Authorizer.Model.Name] = r.Authorizer
+		}
+	}
+	authorizers := make([]*Authorizer, 0, len(byModel))
+	for _, a := range byModel {
+		authorizers = append(authorizers, a)
+	}
+	sort.Slice(authorizers, func(i, j int) bool {
+		return authorizers[i].Model.Name < authorizers[j].Model.Name
+	})
+	return authorizers
+}
+
+// setRelationAuthorizers sets the authorizer of the related model of the add, remove and set relation mutations when
+// it has generated resolvers, only then the resolver has a field with it
+func setRelationAuthorizers(resolvers []*Resolver) {
+	authorizers := getAuthorizers(resolvers)
+	for _, r := range resolvers {
+		if !r.IsAddRelation && !r.IsRemoveRelation && !r.IsSetRelation {
+			continue
+		}
+		for _, a := range authorizers {
+			if a.Model.Name == r.RelationModel.Name {
+				r.RelationAuthorizer = a
+			}
+		}
+	}
+}
+
+// CanCreateCall returns the CanCreate call of the authorizer of the model of a create input which is nested in another
+// one e.g. r.tagAuthorizer.CanCreate(ctx, childInput), it is empty when the model has no authorizer
+func (b *ResolverBuild) CanCreateCall(input *Model, inputExpression string) string {
+	for _, a := range b.Authorizers {
+		if (a.CreateInput != nil && a.CreateInput.Name == input.Name) || (a.CreateInput == nil &&
+			a.Model.BoilerModel != nil && input.BoilerModel != nil && a.Model.BoilerModel.Name == input.BoilerModel.Name) {
+			return (&Resolver{Authorizer: a}).AuthorizerCall("CanCreate", inputExpression)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,75 @@
+package gqlgen_sqlboiler
+
+import "testing"
+
+func TestAuthorizerCall(t *testing.T) {
+	models := []*Model{{Name: "Post"}, {Name: "PostCreateInput"}, {Name: "Tag"}}
+	post := &Resolver{Authorizer: getAuthorizer(models, "Post")}
+	tag := &Resolver{Authorizer: getAuthorizer(models, "Tag")}
+
+	tests := []struct {
+		resolver *Resolver
+		method   string
+		input    string
+		expected string
+	}{
+		{post, "CanCreate", "&input", "r.postAuthorizer.CanCreate(ctx, &input)"},
+		{post, "CanUpdate", "&input", "r.postAuthorizer.CanUpdate(ctx)"},
+		{post, "CanRead", "", "r.postAuthorizer.CanRead(ctx)"},
+		{tag, "CanCreate", "row", "r.tagAuthorizer.CanCreate(ctx)"},
+		{tag, "CanDelete", "m", "r.tagAuthorizer.CanDelete(ctx, m)"},
+		{&Resolver{}, "CanRead", "", ""},
+	}
+	for _, test := range tests {
+		if call := test.resolver.AuthorizerCall(test.method, test.input); call != test.expected {
+			t.Errorf("%v should be %q but is %q", test.method, test.expected, call)
+		}
+	}
+}
+
+func TestCanCreateCall(t *testing.T) {
+	models := []*Model{
+		{Name: "Post", BoilerModel: &BoilerModel{Name: "Post"}},
+		{Name: "PostCreateInput", BoilerModel: &BoilerModel{Name: "Post"}},
+		{Name: "Tag", BoilerModel: &BoilerModel{Name: "Tag"}},
+		{Name: "TagCreateInput", BoilerModel: &BoilerModel{Name: "Tag"}},
+		{Name: "CommentCreateInput", BoilerModel: &BoilerModel{Name: "Comment"}},
+	}
+	build := &ResolverBuild{Authorizers: []*Authorizer{getAuthorizer(models, "Post"), {Model: models[2]}}}
+
+	tests := []struct {
+		input    *Model
+		expected string
+	}{
+		{models[1], "r.postAuthorizer.CanCreate(ctx, childInput)"},
+		{models[3], "r.tagAuthorizer.CanCreate(ctx)"},
+		{models[4], ""},
+	}
+	for _, test := range tests {
+		if call := build.CanCreateCall(test.input, "childInput"); call != test.expected {
+			t.Errorf("%v should be authorized with %q but is %q", test.input.Name, test.expected, call)
+		}
+	}
+}
+
+func TestSetRelationAuthorizers(t *testing.T) {
+	models := []*Model{
+		{Name: "Post", BoilerModel: &BoilerModel{Name: "Post"}},
+		{Name: "Tag", BoilerModel: &BoilerModel{Name: "Tag"}},
+		{Name: "Category", BoilerModel: &BoilerModel{Name: "Category"}},
+	}
+	post, tag, category := *models[0], *models[1], *models[2]
+	createTag := &Resolver{Model: tag, IsCreate: true, Authorizer: getAuthorizer(models, "Tag")}
+	addPostTags := &Resolver{Model: post, RelationModel: tag, IsAddRelation: true, Authorizer: getAuthorizer(models, "Post")}
+	addPostCategories := &Resolver{Model: post, RelationModel: category, IsAddRelation: true,
+		Authorizer: getAuthorizer(models, "Post")}
+	setRelationAuthorizers([]*Resolver{createTag, addPostTags, addPostCategories})
+
+	if addPostTags.RelationAuthorizer == nil || addPostTags.RelationAuthorizer.FieldName() != "tagAuthorizer" {
+		t.Errorf("addPostTags should read the tags with the tagAuthorizer but has %v", addPostTags.RelationAuthorizer)
+	}
+	if addPostCategories.RelationAuthorizer != nil {
+		t.Errorf("categories have no generated resolvers so they have no authorizer but got %v",
+			addPostCategories.RelationAuthorizer)
+	}
+}
@@ -0,0 +1,289 @@
+package gqlgen_sqlboiler
+
+import (
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+	"golang.org/x/tools/go/packages"
+)
+
+// testdata/blog is a project which uses the plugins, its helpers and graph directories hold the code they generate
+const blogDirectory = "testdata/blog"
+
+var update = flag.Bool("update", false, "write the generated code of testdata/blog instead of comparing it")
+
+// TestGenerateBlog runs the convert and resolver plugins over testdata/blog in dry run mode so it fails when the code
+// they generate differs from the code in testdata/blog. Run go test -run TestGenerateBlog -update after changing a
+// template and review the changes of the generated code.
+func TestGenerateBlog(t *testing.T) {
+	// gqlgen looks up the name of every import, the modules of the generated code should not be added to go.mod
+	t.Setenv("GOFLAGS", "-mod=readonly")
+	wd, _ := os.Getwd()
+	defer os.Chdir(wd) //nolint:errcheck
+	if err := os.Chdir(blogDirectory); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := config.LoadConfig("gqlgen.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pluginConfig, err := LoadPluginConfig("gqlgen-sqlboiler.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pluginConfig.DryRun = !*update
+	convertPlugin := NewConvertPluginFromConfig(pluginConfig).(*ConvertPlugin)
+	resolverPlugin := NewResolverPluginFromConfig(pluginConfig).(*ResolverPlugin)
+
+	// the same steps as api.Generate without the plugins of gqlgen itself
+	if source := convertPlugin.InjectSourceEarly(); source != nil {
+		cfg.Sources = append(cfg.Sources, source)
+	}
+	if err := cfg.LoadSchema(); err != nil {
+		t.Fatal(err)
+	}
+	loadPackages(t, cfg, "github.com/99designs/gqlgen/graphql", "github.com/99designs/gqlgen/graphql/introspection",
+		cfg.Model.ImportPath())
+	if err := cfg.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := convertPlugin.MutateConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+	bindGraphQLModels(cfg)
+	data, err := codegen.BuildData(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := resolverPlugin.GenerateCode(data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestBlogCompiles builds testdata/blog with the code of TestGenerateBlog and runs its tests e.g. of the error codes
+// of the resolvers. It needs to download the modules of testdata/blog, use -short to leave it out when you are offline.
+func TestBlogCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs go test on the generated code")
+	}
+	// go.mod is copied since utils-go is left out of it, the generated code should build with its latest version
+	modFile := filepath.Join(t.TempDir(), "go.mod")
+	for _, filename := range []string{"go.mod", "go.sum"} {
+		b, err := ioutil.ReadFile(filepath.Join(blogDirectory, filename))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(filepath.Dir(modFile), filename), b, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tidy := exec.Command("go", "mod", "tidy", "-modfile="+modFile)
+	tidy.Dir = blogDirectory
+	if output, err := tidy.CombinedOutput(); err != nil {
+		t.Fatalf("could not download the modules of %v: %v\n%s", blogDirectory, err, output)
+	}
+	test := exec.Command("go", "test", "-mod=readonly", "-modfile="+modFile, "./...")
+	test.Dir = blogDirectory
+	if output, err := test.CombinedOutput(); err != nil {
+		t.Errorf("generated code of %v does not compile or its tests fail: %v\n%s", blogDirectory, err, output)
+	}
+}
+
+// loadPackages fills the packages cache of gqlgen. gqlgen v0.11.3 loads packages without their dependencies which
+// newer versions of x/tools no longer type check, so they are loaded here together with their dependencies.
+func loadPackages(t *testing.T, cfg *config.Config, importPaths ...string) {
+	t.Helper()
+	loaded, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedDeps | packages.NeedTypes |
+			packages.NeedSyntax | packages.NeedTypesInfo,
+	}, importPaths...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := map[string]*packages.Package{}
+	var add func(pkg *packages.Package)
+	add = func(pkg *packages.Package) {
+		if _, ok := cache[pkg.PkgPath]; ok {
+			return
+		}
+		cache[pkg.PkgPath] = pkg
+		for _, imported := range pkg.Imports {
+			add(imported)
+		}
+	}
+	for _, pkg := range loaded {
+		if len(pkg.Errors) > 0 {
+			t.Fatalf("could not load %v: %v", pkg.PkgPath, pkg.Errors)
+		}
+		add(pkg)
+	}
+
+	// the packages cache of gqlgen is internal so its unexported map is set by reflection
+	packagesField := reflect.ValueOf(cfg).Elem().FieldByName("Packages")
+	packagesField.Set(reflect.New(packagesField.Type().Elem()))
+	cacheField := packagesField.Elem().FieldByName("packages")
+	reflect.NewAt(cacheField.Type(), unsafe.Pointer(cacheField.UnsafeAddr())).Elem().Set(reflect.ValueOf(cache))
+}
+
+// bindGraphQLModels binds the types of the schema to testdata/blog/graphql_models like the models plugin of gqlgen
+// does after generating them
+func bindGraphQLModels(cfg *config.Config) {
+	for _, definition := range cfg.Schema.Types {
+		if definition.BuiltIn || cfg.Models.Exists(definition.Name) ||
+			definition == cfg.Schema.Query || definition == cfg.Schema.Mutation {
+			continue
+		}
+		cfg.Models.Add(definition.Name, cfg.Model.ImportPath()+"."+templates.ToGo(definition.Name))
+	}
+}
+
+// blogFunction returns the source of the function or method with the name in a generated file of testdata/blog, the
+// whitespace is collapsed so it can be searched for a single line of code
+func blogFunction(t *testing.T, filename, name string) string {
+	t.Helper()
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, filepath.Join(blogDirectory, filename), nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, decl := range file.Decls {
+		if function, ok := decl.(*ast.FuncDecl); ok && function.Name.Name == name {
+			var b strings.Builder
+			if err := printer.Fprint(&b, fileSet, function); err != nil {
+				t.Fatal(err)
+			}
+			return strings.Join(strings.Fields(b.String()), " ")
+		}
+	}
+	t.Fatalf("%v is not generated in %v", name, filename)
+	return ""
+}
+
+// testBlogFunction checks that the generated function contains every line of code
+func testBlogFunction(t *testing.T, filename, name string, contains ...string) {
+	t.Helper()
+	function := blogFunction(t, filename, name)
+	for _, code := range contains {
+		if !strings.Contains(function, code) {
+			t.Errorf("%v should contain %q:\n%v", name, code, function)
+		}
+	}
+}
+
+func TestBlogMutationsUseTransactions(t *testing.T) {
+	for _, name := range []string{"CreatePost", "CreatePosts", "UpdatePost", "DeletePosts", "SetPostTags"} {
+		testBlogFunction(t, "graph/resolver.go", name,
+			"tx, err := r.db.BeginTx(ctx, nil)", "_ = tx.Rollback()", "if err := tx.Commit(); err != nil {")
+	}
+	// the nested relations are inserted with the transaction of the mutation
+	testBlogFunction(t, "graph/resolver.go", "CreatePost", "insertPostCreateInput(ctx, tx, m,")
+	testBlogFunction(t, "graph/resolver.go", "insertPostCreateInput",
+		"m.Insert(ctx, exec, whiteList)", "insertCommentCreateInput( ctx, exec,", "m.AddTags(ctx, exec, false, child)")
+	if insert := blogFunction(t, "graph/resolver.go", "insertPostCreateInput"); strings.Contains(insert, "r.db") {
+		t.Errorf("insertPostCreateInput should not use the database outside the transaction:\n%v", insert)
+	}
+}
+
+// TestBlogResolverUsesDB checks the functions testdata/blog/server creates the resolvers with, TestBlogCompiles builds
+// it with an instrumented DB
+func TestBlogResolverUsesDB(t *testing.T) {
+	testBlogFunction(t, "graph/resolver.go", "NewResolver", "func NewResolver(db DB, options ...ResolverOption) *Resolver")
+	testBlogFunction(t, "helpers/db.go", "SQLDB", "func SQLDB(db *sql.DB) DB")
+	testBlogFunction(t, "helpers/db.go", "SQLTx", "func SQLTx(tx *sql.Tx) DB")
+}
+
+func TestBlogQueriesUseReader(t *testing.T) {
+	for _, name := range []string{"Post", "Posts", "PostLike", "PostLikes", "Comment", "Comments"} {
+		testBlogFunction(t, "graph/resolver.go", name, "(ctx, r.readDB(ctx))")
+	}
+	testBlogFunction(t, "graph/resolver.go", "readDB", "if r.reader == nil || IsPrimaryForced(ctx) { return r.db }")
+	// the payload of a mutation is read from the writer since the reader might not have the changes yet
+	for _, name := range []string{"CreatePost", "CreatePosts", "UpdatePost", "CreateComment", "AddPostTags"} {
+		if mutation := blogFunction(t, "graph/resolver.go", name); strings.Contains(mutation, "readDB") {
+			t.Errorf("%v should only use the writer:\n%v", name, mutation)
+		}
+	}
+}
+
+// TestBlogNestedCreates checks the inserts of createPostLike(input: {post: {comments: [{content: ""}]}}), a post like
+// with a new post and its comments
+func TestBlogNestedCreates(t *testing.T) {
+	// to-one relations are inserted first so their foreign key can be set
+	testBlogFunction(t, "graph/resolver.go", "insertPostLikeCreateInput",
+		"insertPostCreateInput( ctx, exec, post, input.Post, GetNestedInput(rawInput, \"post\"), )", "m.PostID = post.ID")
+	// to-many relations are inserted after the row with the foreign key to it
+	testBlogFunction(t, "graph/resolver.go", "insertPostCreateInput",
+		"child.PostID = m.ID", "rawChildrenComments[i], dm.CommentColumns.PostID, )")
+	testBlogFunction(t, "helpers/convert_input.go", "PostLikeCreateInputToBoilerWhitelist",
+		"case \"post\": columnsWhichAreSet = append(columnsWhichAreSet, models.PostLikeColumns.PostID)")
+}
+
+// TestBlogRejectsScopedRelations checks createComment(input: {user: {}}), the user_id of a comment is set by the scope
+// so a nested user is rejected instead of being inserted and left without comment
+func TestBlogRejectsScopedRelations(t *testing.T) {
+	testBlogFunction(t, "graph/resolver.go", "insertCommentCreateInput",
+		"if input.User != nil { return NewPublicError(ErrorCodeValidation, \"user can not be created here\") }",
+		"m.UserID = auth.UserIDFromContext(ctx)")
+	if insert := blogFunction(t, "graph/resolver.go", "insertCommentCreateInput"); strings.Contains(insert,
+		"insertUserCreateInput") {
+		t.Errorf("insertCommentCreateInput should not insert the user:\n%v", insert)
+	}
+}
+
+func TestBlogSkipsCustomResolvers(t *testing.T) {
+	// graph/setting_custom.go implements the setting query
+	testBlogFunction(t, "graph/setting_custom.go", "Setting", "dm.FindSetting(ctx, r.readDB(ctx), id)")
+	generated, err := ioutil.ReadFile(filepath.Join(blogDirectory, "graph", "resolver.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(generated), "func (r *queryResolver) Setting(") {
+		t.Errorf("the setting query is implemented in graph/setting_custom.go so it should not be generated")
+	}
+	testBlogFunction(t, "graph/resolver.go", "CreateSetting", "insertSettingCreateInput(ctx, tx, m,")
+}
+
+// TestBlogAuthorizesNestedCreates checks that the authorizer of every nested relation is asked before it is inserted
+func TestBlogAuthorizesNestedCreates(t *testing.T) {
+	testBlogFunction(t, "graph/resolver.go", "insertPostCreateInput",
+		"if err := r.commentAuthorizer.CanCreate(ctx, childInput); err != nil { return forbiddenError(err) }",
+		"if err := r.tagAuthorizer.CanCreate(ctx, childInput); err != nil { return forbiddenError(err) }")
+	testBlogFunction(t, "graph/resolver.go", "insertPostLikeCreateInput",
+		"if err := r.postAuthorizer.CanCreate(ctx, input.Post); err != nil { return forbiddenError(err) }")
+}
+
+// TestBlogDeletesCheckedRow checks that a delete removes the row CanDelete got in the same transaction
+func TestBlogDeletesCheckedRow(t *testing.T) {
+	for _, name := range []string{"DeletePost", "DeleteComment", "DeletePostLike", "DeleteSetting"} {
+		testBlogFunction(t, "graph/resolver.go", name, "tx, err := r.db.BeginTx(ctx, nil)", "(mods...).One(ctx, tx)",
+			"CanDelete(ctx, m); err != nil { _ = tx.Rollback()", "m.Delete(ctx, tx", "if err := tx.Commit(); err != nil {")
+		if mutation := blogFunction(t, "graph/resolver.go", name); strings.Contains(mutation, "DeleteAll") {
+			t.Errorf("%v should only delete the row which is checked:\n%v", name, mutation)
+		}
+	}
+}
+
+// TestBlogRelatesReadableRows checks that addPostTags, removePostTags and setPostTags only find the tags the user can
+// read, the others are NOT_FOUND
+func TestBlogRelatesReadableRows(t *testing.T) {
+	for _, name := range []string{"AddPostTags", "RemovePostTags", "SetPostTags"} {
+		testBlogFunction(t, "graph/resolver.go", name,
+			"relatedMods = append(relatedMods, r.tagAuthorizer.ReadMods(ctx)...)",
+			"dm.Tags(relatedMods...).All(ctx, r.db)", "if len(related) != len(tagIds) {")
+	}
+}
@@ -3,11 +3,10 @@ package gqlgen_sqlboiler
 import (
 	"fmt"
 	"go/types"
-	"io/ioutil"
+	"os"
 	"path"
 	"path/filepath"
 	"regexp"
-	"runtime"
 	"sort"
 	"strings"
 
@@ -16,6 +15,7 @@ import (
 	"github.com/99designs/gqlgen/plugin"
 	"github.com/iancoleman/strcase"
 	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
 	pluralize "github.com/web-ridge/go-pluralize"
 )
 
@@ -29,16 +29,20 @@ func init() { //nolint:gochecknoinits
 	pathRegex = regexp.MustCompile(`src/(.*)`)
 }
 
+// ModelBuild is the data of the convert, filter, preload, db, errors and pagination templates. Custom templates depend on it
+// so fields are only added, not renamed or removed.
 type ModelBuild struct {
 	Backend             Config
 	Frontend            Config
 	HasStringPrimaryIDs bool
-	PluginConfig        ConvertPluginConfig
-	PackageName         string
-	Interfaces          []*Interface
-	Models              []*Model
-	Enums               []*Enum
-	Scalars             []string
+	// HasCompositePrimaryKeys is true when a model has a primary key of multiple columns
+	HasCompositePrimaryKeys bool
+	PluginConfig            ConvertPluginConfig
+	PackageName             string
+	Interfaces              []*Interface
+	Models                  []*Model
+	Enums                   []*Enum
+	Scalars                 []string
 }
 
 type Interface struct {
@@ -63,20 +67,32 @@ type Model struct { //nolint:maligned
 	IsUpdateInput         bool
 	IsNormalInput         bool
 	IsPayload             bool
+	IsConnection          bool
+	IsEdge                bool
+	IsOrderBy             bool
 	IsWhere               bool
 	IsFilter              bool
 	IsPreloadable         bool
 	PreloadArray          []Preload
+	OrderByFields         []*OrderByField
 	HasOrganizationID     bool
 	HasUserOrganizationID bool
 	HasUserID             bool
 	HasStringPrimaryID    bool
+	// HasCompositePrimaryKey is true when the table has a primary key of multiple columns e.g. post_id and tag_id
+	HasCompositePrimaryKey bool
 	// other stuff
 	Description string
 	PureFields  []*ast.FieldDefinition
 	Implements  []string
 }
 
+// OrderByField is a value of the e.g. PostOrderByField enum with the column it sorts on
+type OrderByField struct {
+	Name        string
+	BoilerField *BoilerField
+}
+
 type ColumnSetting struct {
 	Name                  string
 	RelationshipModelName string
@@ -92,23 +108,39 @@ type Field struct { //nolint:maligned
 	IsNumberID         bool
 	IsPrimaryNumberID  bool
 	IsPrimaryID        bool
-	IsRequired         bool
-	IsPlural           bool
-	ConvertConfig      ConvertConfig
+	// IsCompositePrimaryID is true for the id of a model with a primary key of multiple columns
+	IsCompositePrimaryID bool
+	IsRequired           bool
+	IsPlural             bool
+	ConvertConfig        ConvertConfig
 	// relation stuff
 	IsRelation bool
 	// boiler relation stuff is inside this field
 	BoilerField BoilerField
 	// graphql relation ship can be found here
 	Relationship *Model
-	IsOr         bool
-	IsAnd        bool
+	// create input of the relationship when nesting creates e.g. CommentCreateInput for comments in PostCreateInput
+	RelationshipInput *Model
+	// input nested in an input e.g. UserUpdateInput for user in PostUpdateInput, its validations are checked too
+	NestedInput *Model
+	// foreign key in the relationship which points back to this model e.g. Comment.PostID for Post.comments
+	InverseForeignKey *BoilerField
+	IsOr              bool
+	IsAnd             bool
+	// Validation of an input field by its directives e.g. @constraint(maxLength: 120), nil when it has none
+	Validation *Validation
 
 	// Some stuff
 	Description  string
 	OriginalType types.Type
 }
 
+// IsList is true for e.g. []*CommentCreateInput
+func (f *Field) IsList() bool {
+	_, isSlice := f.OriginalType.(*types.Slice)
+	return isSlice
+}
+
 type Enum struct {
 	Description string
 	Name        string
@@ -124,11 +156,10 @@ type EnumValue struct {
 
 func NewConvertPlugin(output, backend, frontend Config, pluginConfig ConvertPluginConfig) plugin.Plugin {
 	return &ConvertPlugin{
-		Output:         output,
-		Backend:        backend,
-		Frontend:       frontend,
-		PluginConfig:   pluginConfig,
-		rootImportPath: getRootImportPath(),
+		Output:       output,
+		Backend:      backend,
+		Frontend:     frontend,
+		PluginConfig: pluginConfig,
 	}
 }
 
@@ -138,46 +169,185 @@ type ConvertPlugin struct {
 	Frontend       Config
 	PluginConfig   ConvertPluginConfig
 	rootImportPath string
+	// injectError is returned by MutateConfig since InjectSourceEarly can not return an error
+	injectError error
 }
 
 type Config struct {
-	Directory   string
-	PackageName string
+	Directory   string `yaml:"directory"`
+	PackageName string `yaml:"package"`
 }
 
 type ConvertPluginConfig struct {
-	UseReflectWorkaroundForSubModelFilteringInPostgresIssue25 bool
+	UseReflectWorkaroundForSubModelFilteringInPostgresIssue25 bool `yaml:"use_reflect_workaround_for_sub_model_filtering_in_postgres_issue_25"` //nolint:lll
+	// DatabaseDriver is used to escape column names the right way, defaults to Postgres
+	DatabaseDriver DatabaseDriver `yaml:"database_driver"`
+	// GenerateOrderBy adds a PostOrderBy input and PostOrderByField enum for every sqlboiler model to your schema,
+	// leave this off if you define these yourself
+	GenerateOrderBy bool `yaml:"generate_order_by"`
+	// TemplateDirectory holds your own templates which override the built-in templates or their partials, the data
+	// they get is ModelBuild
+	TemplateDirectory string `yaml:"template_directory"`
+	// DryRun does not write the generated files but prints how they differ from the files on disk, generating fails
+	// with ErrNotUpToDate when they differ. Set it with dry_run or from code e.g. a -check flag in CI. The files of
+	// gqlgen itself e.g. models_gen.go and the executable schema are still written, gqlgen has no option to leave them
+	// alone, so check those with git diff --exit-code.
+	DryRun bool `yaml:"dry_run"`
+}
+
+type DatabaseDriver string
+
+const (
+	Postgres DatabaseDriver = "postgres"
+	MySQL    DatabaseDriver = "mysql"
+	SQLite   DatabaseDriver = "sqlite3"
+)
+
+// IsValid is true for the drivers we support, empty means the default Postgres
+func (d DatabaseDriver) IsValid() bool {
+	switch d {
+	case "", Postgres, MySQL, SQLite:
+		return true
+	}
+	return false
 }
 
-var _ plugin.ConfigMutator = &ConvertPlugin{}
+// IdentifierQuote is the character the database uses to escape table and column names
+func (d DatabaseDriver) IdentifierQuote() string {
+	if d == MySQL {
+		return "`"
+	}
+	return `"`
+}
+
+var (
+	_ plugin.ConfigMutator       = &ConvertPlugin{}
+	_ plugin.EarlySourceInjector = &ConvertPlugin{}
+)
 
 func (m *ConvertPlugin) Name() string {
 	return "convert-generator"
 }
 
+// InjectSourceEarly adds the order by types to the schema before it is loaded so they can be used as arguments
+func (m *ConvertPlugin) InjectSourceEarly() *ast.Source {
+	if !m.PluginConfig.GenerateOrderBy {
+		return nil
+	}
+	boilerModels, err := GetBoilerModels(m.Backend.Directory)
+	if err != nil {
+		m.injectError = err
+		return nil
+	}
+	input := getOrderBySchema(boilerModels, getDefinedTypes())
+	if input == "" {
+		return nil
+	}
+	return &ast.Source{
+		Name:  "gqlgen-sqlboiler/order_by.graphql",
+		Input: input,
+	}
+}
+
+// gqlgenConfigFilenames are the names gqlgen looks for, it changes the working directory to the directory of the file
+// before the plugins run so we only look in there
+var gqlgenConfigFilenames = []string{".gqlgen.yml", "gqlgen.yml", "gqlgen.yaml"} //nolint:gochecknoglobals
+
+// getDefinedTypes returns the names of the types in the schema of gqlgen.yml, the schema is not loaded yet when the
+// order by types are added so we parse it ourselves
+func getDefinedTypes() map[string]bool {
+	definedTypes := map[string]bool{}
+	for _, filename := range gqlgenConfigFilenames {
+		if _, err := os.Stat(filename); err != nil {
+			continue
+		}
+		cfg, err := config.LoadConfig(filename)
+		if err != nil {
+			return definedTypes
+		}
+		schema, parseErr := parser.ParseSchemas(cfg.Sources...)
+		if parseErr != nil {
+			return definedTypes
+		}
+		for _, definition := range schema.Definitions {
+			definedTypes[definition.Name] = true
+		}
+		return definedTypes
+	}
+	return definedTypes
+}
+
+// getOrderBySchema returns the SortDirection enum and the order by types of every model, types you defined yourself
+// are left out. Models without columns to sort on get no order by types since an enum needs a value.
+func getOrderBySchema(boilerModels []*BoilerModel, definedTypes map[string]bool) string {
+	var sb strings.Builder
+	for _, boilerModel := range boilerModels {
+		if definedTypes[boilerModel.Name+"OrderByField"] || definedTypes[boilerModel.Name+"OrderBy"] {
+			continue
+		}
+		var values []string
+		for _, field := range boilerModel.Fields {
+			if field.IsRelation && !field.IsForeignKey {
+				continue
+			}
+			values = append(values, strcase.ToScreamingSnake(field.Name))
+		}
+		if len(values) == 0 {
+			continue
+		}
+		sb.WriteString("\nenum " + boilerModel.Name + "OrderByField {\n")
+		for _, value := range values {
+			sb.WriteString("  " + value + "\n")
+		}
+		sb.WriteString("}\n")
+		sb.WriteString("\ninput " + boilerModel.Name + "OrderBy {\n")
+		sb.WriteString("  field: " + boilerModel.Name + "OrderByField!\n")
+		sb.WriteString("  direction: SortDirection! = ASC\n")
+		sb.WriteString("}\n")
+	}
+	if sb.Len() == 0 || definedTypes["SortDirection"] {
+		return sb.String()
+	}
+	return "enum SortDirection {\n  ASC\n  DESC\n}\n" + sb.String()
+}
+
 func copyConfig(cfg config.Config) *config.Config {
 	return &cfg
 }
 
-func GetModelsWithInformation(enums []*Enum, cfg *config.Config, boilerModels []*BoilerModel) []*Model {
+// GetModelsWithInformation binds the schema to the sqlboiler models, the models are returned together with the
+// fields which could not be bound so you can see everything which is wrong at once
+func GetModelsWithInformation(enums []*Enum, cfg *config.Config, boilerModels []*BoilerModel) ([]*Model, error) {
 	// get models based on the schema and sqlboiler structs
 	models := getModelsFromSchema(cfg.Schema, boilerModels)
 
 	// Now we have all model's let enhance them with fields
-	enhanceModelsWithFields(enums, cfg.Schema, cfg, models)
+	err := enhanceModelsWithFields(enums, cfg.Schema, cfg, models)
 
 	// Add preload maps
 	enhanceModelsWithPreloadArray(models)
 
+	// Add the columns of the order by enums
+	enhanceModelsWithOrderByFields(cfg.Schema, models)
+
 	// Sort in same order
 	sort.Slice(models, func(i, j int) bool { return models[i].Name < models[j].Name })
 	for _, m := range models {
 		cfg.Models.Add(m.Name, cfg.Model.ImportPath()+"."+templates.ToGo(m.Name))
 	}
-	return models
+	return models, err
 }
 
 func (m *ConvertPlugin) MutateConfig(originalCfg *config.Config) error {
+	if m.injectError != nil {
+		return m.injectError
+	}
+	rootImportPath, err := getRootImportPath()
+	if err != nil {
+		return err
+	}
+	m.rootImportPath = rootImportPath
+
 	b := &ModelBuild{
 		PackageName: m.Output.PackageName,
 		Backend: Config{
@@ -191,25 +361,34 @@ func (m *ConvertPlugin) MutateConfig(originalCfg *config.Config) error {
 		PluginConfig: m.PluginConfig,
 	}
 
+	// the generated Validate functions check the validation directives instead of gqlgen
+	skipValidationDirectivesAtRuntime(originalCfg)
+
 	cfg := copyConfig(*originalCfg)
 
 	fmt.Println("[convert] get boiler models")
-	boilerModels := GetBoilerModels(m.Backend.Directory)
+	boilerModels, err := GetBoilerModels(m.Backend.Directory)
+	if err != nil {
+		return err
+	}
 
 	fmt.Println("[convert] get extra's from schema")
 	interfaces, enums, scalars := getExtrasFromSchema(cfg.Schema)
 
 	fmt.Println("[convert] get model with information")
-	models := GetModelsWithInformation(enums, originalCfg, boilerModels)
+	var errs GenerateErrors
+	models, err := GetModelsWithInformation(enums, originalCfg, boilerModels)
+	errs.Append("", err)
 
 	b.Models = models
 	b.HasStringPrimaryIDs = HasStringPrimaryIDsInModels(models)
+	b.HasCompositePrimaryKeys = HasCompositePrimaryKeysInModels(models)
 	b.Interfaces = interfaces
 	b.Enums = enums
 	b.Scalars = scalars
 	if len(b.Models) == 0 {
 		fmt.Println("No models found in graphql so skipping generation")
-		return nil
+		return errs.ErrorOrNil()
 	}
 
 	// for _, model := range models {
@@ -220,68 +399,61 @@ func (m *ConvertPlugin) MutateConfig(originalCfg *config.Config) error {
 	// 	}
 	// }
 
-	fmt.Println("[convert] render preload.gotpl")
-	templates.CurrentImports = nil
-	if renderError := templates.Render(templates.Options{
-		Template:        getTemplate("preload.gotpl"),
-		PackageName:     m.Output.PackageName,
-		Filename:        m.Output.Directory + "/" + "preload.go",
-		Data:            b,
-		GeneratedHeader: true,
-		Packages:        cfg.Packages,
-	}); renderError != nil {
-		fmt.Println("renderError", renderError)
-	}
-	templates.CurrentImports = nil
-	fmt.Println("[convert] render convert.gotpl")
-	if renderError := templates.Render(templates.Options{
-		Template:        getTemplate("convert.gotpl"),
-		PackageName:     m.Output.PackageName,
-		Filename:        m.Output.Directory + "/" + "convert.go",
-		Data:            b,
-		GeneratedHeader: true,
-		Packages:        cfg.Packages,
-	}); renderError != nil {
-		fmt.Println("renderError", renderError)
-	}
-	templates.CurrentImports = nil
-	fmt.Println("[convert] render convert_input.gotpl")
-	if renderError := templates.Render(templates.Options{
-		Template:        getTemplate("convert_input.gotpl"),
-		PackageName:     m.Output.PackageName,
-		Filename:        m.Output.Directory + "/" + "convert_input.go",
-		Data:            b,
-		GeneratedHeader: true,
-		Packages:        cfg.Packages,
-	}); renderError != nil {
-		fmt.Println("renderError", renderError)
-	}
-	templates.CurrentImports = nil
-	fmt.Println("[convert] render filter.gotpl")
-	if renderError := templates.Render(templates.Options{
-		Template:        getTemplate("filter.gotpl"),
-		PackageName:     m.Output.PackageName,
-		Filename:        m.Output.Directory + "/" + "filter.go",
-		Data:            b,
-		GeneratedHeader: true,
-		Packages:        cfg.Packages,
-	}); renderError != nil {
-		fmt.Println("renderError", renderError)
+	filenames := []string{"preload", "convert", "convert_input", "filter", "db", "errors"}
+	if HasConnectionsInModels(models) {
+		filenames = append(filenames, "pagination")
 	}
-
-	return nil
+	for _, filename := range filenames {
+		fmt.Println("[convert] render " + filename + ".gotpl")
+		errs.Append(filename+".gotpl", m.render(cfg, b, filename))
+	}
+	return errs.ErrorOrNil()
 }
 
-func getTemplate(filename string) string {
-	// load path relative to calling source file
-	_, callerFile, _, _ := runtime.Caller(1) //nolint:dogsled
-	rootDir := filepath.Dir(callerFile)
-	content, err := ioutil.ReadFile(path.Join(rootDir, filename))
+// render writes e.g. convert.gotpl to convert.go in the output directory, if the template fails we render it again
+// per model and field to find out which ones are failing
+func (m *ConvertPlugin) render(cfg *config.Config, b *ModelBuild, filename string) error {
+	template, err := loadTemplate(m.PluginConfig.TemplateDirectory, filename+".gotpl")
 	if err != nil {
-		fmt.Println("Could not read .gotpl file", err)
-		return "Could not read .gotpl file"
+		return err
+	}
+	render := func(b *ModelBuild, outputDirectory string) error {
+		templates.CurrentImports = nil
+		return templates.Render(templates.Options{
+			Template:        template,
+			PackageName:     m.Output.PackageName,
+			Filename:        outputDirectory + "/" + filename + ".go",
+			Data:            b,
+			GeneratedHeader: true,
+			Packages:        cfg.Packages,
+		})
+	}
+	if m.PluginConfig.DryRun {
+		err = dryRun(m.Output.Directory+"/"+filename+".go", func(generatedFilename string) error {
+			return render(b, filepath.Dir(generatedFilename))
+		})
+	} else {
+		err = render(b, m.Output.Directory)
 	}
-	return string(content)
+	if !isTemplateExecError(err) {
+		return err
+	}
+	return findFailingModels(err, b.Models, func(models []*Model) error {
+		attempt := *b
+		attempt.Models = models
+		return renderInTempDir(func(dir string) error {
+			return render(&attempt, dir)
+		})
+	})
+}
+
+func HasConnectionsInModels(models []*Model) bool {
+	for _, model := range models {
+		if model.IsConnection {
+			return true
+		}
+	}
+	return false
 }
 
 func HasStringPrimaryIDsInModels(models []*Model) bool {
@@ -293,6 +465,15 @@ func HasStringPrimaryIDsInModels(models []*Model) bool {
 	return false
 }
 
+func HasCompositePrimaryKeysInModels(models []*Model) bool {
+	for _, model := range models {
+		if model.IsNormal && model.HasCompositePrimaryKey {
+			return true
+		}
+	}
+	return false
+}
+
 // getFieldType check's if user has defined a
 func getFieldType(binder *config.Binder, schema *ast.Schema, cfg *config.Config, field *ast.FieldDefinition) (
 	types.Type, error) {
@@ -340,7 +521,7 @@ func getFieldType(binder *config.Binder, schema *ast.Schema, cfg *config.Config,
 			)
 
 		default:
-			panic(fmt.Errorf("unknown ast type %s", fieldDef.Kind))
+			return nil, fmt.Errorf("unknown ast type %s", fieldDef.Kind)
 		}
 	}
 
@@ -356,19 +537,25 @@ func getGraphqlFieldName(cfg *config.Config, modelName string, field *ast.FieldD
 	return name
 }
 
-func enhanceModelsWithFields(enums []*Enum, schema *ast.Schema, cfg *config.Config, models []*Model) {
+func enhanceModelsWithFields(enums []*Enum, schema *ast.Schema, cfg *config.Config, models []*Model) error {
+	var errs GenerateErrors
 	binder := cfg.NewBinder()
 
 	// Generate the basic of the fields
 	for _, m := range models {
 		// Let's convert the pure ast fields to something usable for our template
-		for _, field := range m.PureFields {
+		for i, field := range m.PureFields {
 			fieldDef := schema.Types[field.Type.Name()]
 
 			// This calls some qglgen boilerType which gets the gqlgen type
 			typ, err := getFieldType(binder, schema, cfg, field)
 			if err != nil {
-				fmt.Println("Could not get field type from graphql schema: ", err)
+				errs = append(errs, &GenerateError{
+					Model: m.Name,
+					Field: field.Name,
+					Err:   fmt.Errorf("could not get field type from graphql schema: %w", err),
+				})
+				continue
 			}
 			jsonName := getGraphqlFieldName(cfg, m.Name, field)
 			name := getGoFieldName(jsonName)
@@ -394,11 +581,27 @@ func enhanceModelsWithFields(enums []*Enum, schema *ast.Schema, cfg *config.Conf
 
 			// get sqlboiler information of the field
 			boilerField := findBoilerFieldOrForeignKey(m.BoilerModel.Fields, name, isRelation)
+
+			// the id is the primary key of the table which does not have to be named ID
+			isCompositePrimaryID := isPrimaryID && m.BoilerModel.HasCompositePrimaryKey()
+			if primaryKey := m.BoilerModel.PrimaryKey(); isPrimaryID && primaryKey != nil {
+				boilerField = *primaryKey
+			}
+			if isCompositePrimaryID {
+				if !m.IsNormal {
+					fmt.Println("[WARN] id of", m.Name, "is skipped since composite primary keys are only supported in",
+						m.BoilerModel.Name)
+					continue
+				}
+				// the id is made of all primary key columns, see the IDToGraphQL function in the convert template
+				boilerField = BoilerField{Name: "ID", Type: "string"}
+			}
+
 			isString := strings.Contains(strings.ToLower(boilerField.Type), "string")
 			isNumberID := strings.HasSuffix(name, "ID") && !isString
 			isPrimaryNumberID := isPrimaryID && !isString
 
-			isPrimaryStringID := isPrimaryID && isString
+			isPrimaryStringID := isPrimaryID && isString && !isCompositePrimaryID
 			// enable simpler code in resolvers
 
 			if isPrimaryStringID {
@@ -412,7 +615,7 @@ func enhanceModelsWithFields(enums []*Enum, schema *ast.Schema, cfg *config.Conf
 			if boilerField.Type == "" {
 				// TODO: add filter + where here
 				switch {
-				case m.IsPayload:
+				case m.IsPayload || m.IsConnection || m.IsEdge || m.IsOrderBy:
 				case pluralizer.IsPlural(name):
 				case (m.IsFilter || m.IsWhere) && (strings.EqualFold(name, "and") ||
 					strings.EqualFold(name, "or") ||
@@ -426,30 +629,39 @@ func enhanceModelsWithFields(enums []*Enum, schema *ast.Schema, cfg *config.Conf
 			}
 
 			if boilerField.Name == "" {
-				if m.IsPayload || m.IsFilter || m.IsWhere {
+				if m.IsPayload || m.IsConnection || m.IsEdge || m.IsOrderBy || m.IsFilter || m.IsWhere {
 				} else {
 					fmt.Println("[WARN] boiler name not available for ", m.Name+"."+name)
 					continue
 				}
 			}
 			field := &Field{
-				Name:               name,
-				JSONName:           jsonName,
-				Type:               shortType,
-				TypeWithoutPointer: strings.Replace(strings.TrimPrefix(shortType, "*"), ".", "Dot", -1),
-				BoilerField:        boilerField,
-				IsNumberID:         isNumberID,
-				IsPrimaryID:        isPrimaryID,
-				IsPrimaryNumberID:  isPrimaryNumberID,
-				IsRelation:         isRelation,
-				IsOr:               strings.EqualFold(name, "or"),
-				IsAnd:              strings.EqualFold(name, "and"),
-				IsPlural:           pluralizer.IsPlural(name),
-				PluralName:         pluralizer.Plural(name),
-				OriginalType:       typ,
-				Description:        field.Description,
+				Name:                 name,
+				JSONName:             jsonName,
+				Type:                 shortType,
+				TypeWithoutPointer:   strings.Replace(strings.TrimPrefix(shortType, "*"), ".", "Dot", -1),
+				BoilerField:          boilerField,
+				IsNumberID:           isNumberID,
+				IsPrimaryID:          isPrimaryID,
+				IsCompositePrimaryID: isCompositePrimaryID,
+				IsPrimaryNumberID:    isPrimaryNumberID,
+				IsRelation:           isRelation,
+				IsOr:                 strings.EqualFold(name, "or"),
+				IsAnd:                strings.EqualFold(name, "and"),
+				IsPlural:             pluralizer.IsPlural(name),
+				PluralName:           pluralizer.Plural(name),
+				OriginalType:         typ,
+				Description:          field.Description,
 			}
 			field.ConvertConfig = getConvertConfig(enums, m, field)
+			if m.IsInput {
+				validation, err := getValidation(m.PureFields[i], shortType)
+				if err != nil {
+					errs = append(errs, &GenerateError{Model: m.Name, Field: name, Err: err})
+					continue
+				}
+				field.Validation = validation
+			}
 			m.Fields = append(m.Fields, field)
 		}
 	}
@@ -458,12 +670,59 @@ func enhanceModelsWithFields(enums []*Enum, schema *ast.Schema, cfg *config.Conf
 		m.HasOrganizationID = findField(m.Fields, "organizationId") != nil
 		m.HasUserOrganizationID = findField(m.Fields, "userOrganizationId") != nil
 		m.HasUserID = findField(m.Fields, "userId") != nil
+		m.HasCompositePrimaryKey = m.BoilerModel.HasCompositePrimaryKey()
 		for _, f := range m.Fields {
 			if f.BoilerField.Relationship != nil {
 				f.Relationship = findModel(models, f.BoilerField.Relationship.Name)
 			}
+			if m.IsCreateInput && f.IsRelation && f.BoilerField.Relationship != nil {
+				enhanceFieldWithNestedCreate(models, m, f)
+			}
+			if nestedInput := findModel(models, strings.TrimPrefix(f.Type, "*")); m.IsInput && f.IsRelation &&
+				nestedInput != nil && nestedInput.IsInput {
+				f.NestedInput = nestedInput
+			}
 		}
 	}
+	return errs.ErrorOrNil()
+}
+
+// enhanceFieldWithNestedCreate finds out how a nested create input is related to its parent so the resolver can
+// insert it and fill in the foreign keys
+func enhanceFieldWithNestedCreate(models []*Model, m *Model, f *Field) {
+	f.RelationshipInput = findModel(models, strings.TrimLeft(f.Type, "[]*"))
+	if f.RelationshipInput == nil || !f.RelationshipInput.IsCreateInput {
+		fmt.Println("[WARN] nested create not supported for", m.Name+"."+f.Name, "since it's no create input")
+		f.RelationshipInput = nil
+		return
+	}
+	if f.BoilerField.IsForeignKey || f.BoilerField.IsManyToMany {
+		return
+	}
+	f.InverseForeignKey = f.BoilerField.ForeignKey
+	if f.InverseForeignKey == nil {
+		f.InverseForeignKey = findInverseForeignKey(f.BoilerField.Relationship, m.BoilerModel.Name)
+	}
+	if f.InverseForeignKey == nil {
+		fmt.Println("[WARN] nested create not supported for", m.Name+"."+f.Name,
+			"since no foreign key to", m.BoilerModel.Name, "is found")
+		f.RelationshipInput = nil
+	}
+}
+
+// findInverseForeignKey finds e.g. Comment.PostID when Post has many comments
+func findInverseForeignKey(relationship *BoilerModel, modelName string) *BoilerField {
+	var foreignKey *BoilerField
+	for _, field := range relationship.Fields {
+		if !field.IsForeignKey || field.Relationship == nil || field.Relationship.Name != modelName {
+			continue
+		}
+		// prefer PostID over e.g. ParentPostID if there are more
+		if foreignKey == nil || field.Name == modelName+"ID" {
+			foreignKey = field
+		}
+	}
+	return foreignKey
 }
 
 func getGoFieldName(name string) string {
@@ -573,6 +832,11 @@ func getExtrasFromSchema(schema *ast.Schema) (interfaces []*Interface, enums []*
 			scalars = append(scalars, schemaType.Name)
 		}
 	}
+
+	// schema.Types is a map, sort so the generated code is the same every time
+	sort.Slice(interfaces, func(i, j int) bool { return interfaces[i].Name < interfaces[j].Name })
+	sort.Slice(enums, func(i, j int) bool { return enums[i].Name < enums[j].Name })
+	sort.Strings(scalars)
 	return
 }
 
@@ -612,10 +876,19 @@ func getModelsFromSchema(schema *ast.Schema, boilerModels []*BoilerModel) (model
 				isFilter := strings.HasSuffix(modelName, "Filter") && modelName != "Filter"
 				isWhere := strings.HasSuffix(modelName, "Where") && modelName != "Where"
 				isPayload := strings.HasSuffix(modelName, "Payload") && modelName != "Payload"
+				isConnection := strings.HasSuffix(modelName, "Connection") && modelName != "Connection"
+				isEdge := strings.HasSuffix(modelName, "Edge") && modelName != "Edge"
+				isOrderBy := strings.HasSuffix(modelName, "OrderBy") && modelName != "OrderBy"
+
+				// batch payloads are plural e.g. PostsPayload
+				if boilerModel == nil && isPayload {
+					boilerModel = FindBoilerModel(boilerModels, pluralizer.Singular(getBaseModelFromName(modelName)))
+				}
 
 				// if no boiler model is found
 				if boilerModel == nil || boilerModel.Name == "" {
-					if isInput || isWhere || isFilter || isPayload {
+					if isInput || isWhere || isFilter || isPayload || isConnection || isEdge || isOrderBy ||
+						modelName == "PageInfo" {
 						// silent continue
 						continue
 					}
@@ -638,8 +911,11 @@ func getModelsFromSchema(schema *ast.Schema, boilerModels []*BoilerModel) (model
 					IsCreateInput: isCreateInput,
 					IsNormalInput: isNormalInput,
 					IsPayload:     isPayload,
-					IsNormal:      !isInput && !isWhere && !isFilter && !isPayload,
-					IsPreloadable: !isInput && !isWhere && !isFilter && !isPayload,
+					IsConnection:  isConnection,
+					IsEdge:        isEdge,
+					IsOrderBy:     isOrderBy,
+					IsNormal:      !isInput && !isWhere && !isFilter && !isPayload && !isConnection && !isEdge && !isOrderBy,
+					IsPreloadable: !isInput && !isWhere && !isFilter && !isPayload && !isConnection && !isEdge && !isOrderBy,
 				}
 
 				for _, implementor := range schema.GetImplements(schemaType) {
@@ -705,6 +981,44 @@ func enhanceModelsWithPreloadArray(models []*Model) {
 	}
 }
 
+// enhanceModelsWithOrderByFields maps the values of the e.g. PostOrderByField enum to the sqlboiler columns
+func enhanceModelsWithOrderByFields(schema *ast.Schema, models []*Model) {
+	for _, model := range models {
+		if !model.IsOrderBy {
+			continue
+		}
+		enum := schema.Types[model.Name+"Field"]
+		if enum == nil || enum.Kind != ast.Enum {
+			fmt.Printf("[WARN] Skip %v because %vField enum is not found\n", model.Name, model.Name)
+			continue
+		}
+		for _, value := range enum.EnumValues {
+			boilerField := findBoilerFieldForEnumValue(model.BoilerModel.Fields, value.Name)
+			if boilerField == nil {
+				fmt.Printf("[WARN] Skip %v.%v because no database column found\n", enum.Name, value.Name)
+				continue
+			}
+			model.OrderByFields = append(model.OrderByFields, &OrderByField{
+				Name:        value.Name,
+				BoilerField: boilerField,
+			})
+		}
+	}
+}
+
+// findBoilerFieldForEnumValue finds e.g. CreatedAt for CREATED_AT
+func findBoilerFieldForEnumValue(fields []*BoilerField, enumValue string) *BoilerField {
+	for _, field := range fields {
+		if field.IsRelation && !field.IsForeignKey {
+			continue
+		}
+		if strings.EqualFold(field.Name, strings.Replace(enumValue, "_", "", -1)) {
+			return field
+		}
+	}
+	return nil
+}
+
 // The relationship is defined in the normal model but not in the input, where etc structs
 // So just find the normal model and get the relationship type :)
 func getBaseModelFromName(v string) string {
@@ -712,6 +1026,9 @@ func getBaseModelFromName(v string) string {
 	v = safeTrim(v, "UpdateInput")
 	v = safeTrim(v, "Input")
 	v = safeTrim(v, "Payload")
+	v = safeTrim(v, "Connection")
+	v = safeTrim(v, "Edge")
+	v = safeTrim(v, "OrderBy")
 	v = safeTrim(v, "Where")
 	v = safeTrim(v, "Filter")
 	return v
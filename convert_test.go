@@ -3,7 +3,11 @@
 
 package gqlgen_sqlboiler
 
-import "testing"
+import (
+	"os"
+	"strings"
+	"testing"
+)
 
 func TestShortType(t *testing.T) {
 	testShortType(t, "gitlab.com/product/app/backend/graphql_models.FlowWhere", "FlowWhere")
@@ -21,3 +25,173 @@ func testShortType(t *testing.T, input, output string) {
 		t.Errorf("%v should result in %v but did result in %v", input, output, result)
 	}
 }
+
+func TestFindBoilerFieldForEnumValue(t *testing.T) {
+	fields := []*BoilerField{
+		{Name: "ID"},
+		{Name: "CreatedAt"},
+		{Name: "UserID", IsForeignKey: true, IsRelation: true},
+		{Name: "Comments", IsRelation: true},
+	}
+	testFindBoilerFieldForEnumValue(t, fields, "ID", "ID")
+	testFindBoilerFieldForEnumValue(t, fields, "CREATED_AT", "CreatedAt")
+	testFindBoilerFieldForEnumValue(t, fields, "USER_ID", "UserID")
+	testFindBoilerFieldForEnumValue(t, fields, "COMMENTS", "")
+}
+
+func testFindBoilerFieldForEnumValue(t *testing.T, fields []*BoilerField, input, output string) {
+	var result string
+	if field := findBoilerFieldForEnumValue(fields, input); field != nil {
+		result = field.Name
+	}
+	if result != output {
+		t.Errorf("%v should result in %v but did result in %v", input, output, result)
+	}
+}
+
+func TestFindPrimaryKeyFields(t *testing.T) {
+	fields := []*BoilerField{
+		{Name: "ID"},
+		{Name: "PostID"},
+		{Name: "TagID"},
+	}
+	testFindPrimaryKeyFields(t, fields, nil, []string{"ID"})
+	testFindPrimaryKeyFields(t, fields, []string{"PostID", "TagID"}, []string{"PostID", "TagID"})
+	testFindPrimaryKeyFields(t, fields, []string{"Key"}, nil)
+}
+
+func testFindPrimaryKeyFields(t *testing.T, fields []*BoilerField, input, output []string) {
+	var result []string
+	for _, field := range findPrimaryKeyFields(fields, input) {
+		result = append(result, field.Name)
+	}
+	if strings.Join(result, ",") != strings.Join(output, ",") {
+		t.Errorf("%v should result in %v but did result in %v", input, output, result)
+	}
+}
+
+func TestUnquoteIdentifier(t *testing.T) {
+	testUnquoteIdentifier(t, `"id" `, "id")
+	testUnquoteIdentifier(t, `"comments"."post_id"`, "post_id")
+	testUnquoteIdentifier(t, "`post_tags`.`tag_id`", "tag_id")
+	testUnquoteIdentifier(t, `[dbo].[posts]`, "posts")
+}
+
+func testUnquoteIdentifier(t *testing.T, input, output string) {
+	result := unquoteIdentifier(input)
+	if result != output {
+		t.Errorf("%v should result in %v but did result in %v", input, output, result)
+	}
+}
+
+func TestCompositeID(t *testing.T) {
+	src := renderTemplate(t, "convert.gotpl", &ModelBuild{
+		HasCompositePrimaryKeys: true,
+		Backend:                 Config{Directory: "github.com/yourname/app/models", PackageName: "models"},
+		Frontend:                Config{Directory: "github.com/yourname/app/graphql_models", PackageName: "fm"},
+	})
+	testGeneratedCode(t, src, []string{"CompositeIDToGraphQL", "CompositeIDToBoiler"}, `
+var ErrInvalidID = errors.New("invalid id")
+
+func TestCompositeID(t *testing.T) {
+	// the base64 of these values contains a - and a _
+	for _, text := range []string{"a~b~c~", "???", "-_-"} {
+		id := CompositeIDToGraphQL("post_tags", 1, text)
+		var postID int
+		var tag string
+		if err := CompositeIDToBoiler("post_tags", id, &postID, &tag); err != nil {
+			t.Fatalf("%v: %v", id, err)
+		}
+		if postID != 1 || tag != text {
+			t.Errorf("%v should be 1 and %q but is %v and %q", id, text, postID, tag)
+		}
+	}
+	if id := CompositeIDToGraphQL("post_tags", 1, "a~b~c~") + CompositeIDToGraphQL("x", "???"); !strings.Contains(id, "-") ||
+		!strings.Contains(id, "_") {
+		t.Errorf("%v should contain a - and a _", id)
+	}
+
+	var postID, tagID int
+	for _, id := range []string{
+		"posts-WzEsMl0",
+		"post_tags-WzEsMl0!",
+		"post_tags-WzFd",
+		"post_tags-WyJhIiwyXQ",
+		"post_tags",
+	} {
+		if err := CompositeIDToBoiler("post_tags", id, &postID, &tagID); !errors.Is(err, ErrInvalidID) {
+			t.Errorf("%v should be an invalid id but got %v", id, err)
+		}
+	}
+}
+`)
+}
+
+func TestUniqueIDs(t *testing.T) {
+	src := renderTemplate(t, "convert.gotpl", &ModelBuild{
+		Backend:  Config{Directory: "github.com/yourname/app/models", PackageName: "models"},
+		Frontend: Config{Directory: "github.com/yourname/app/graphql_models", PackageName: "fm"},
+	})
+	testGeneratedCode(t, src, []string{"UniqueIDs"}, `
+func TestUniqueIDs(t *testing.T) {
+	ids := UniqueIDs([]string{"tags-2", "tags-1", "tags-2", "tags-2", "tags-3", "tags-1"})
+	if expected := []string{"tags-2", "tags-1", "tags-3"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("ids should be %v but are %v", expected, ids)
+	}
+	if ids := UniqueIDs(nil); len(ids) != 0 {
+		t.Errorf("no ids should stay empty but are %v", ids)
+	}
+}
+`)
+}
+
+func TestGetOrderBySchema(t *testing.T) {
+	boilerModels := []*BoilerModel{
+		{Name: "Post", Fields: []*BoilerField{
+			{Name: "ID"},
+			{Name: "UserID", IsRelation: true, IsForeignKey: true},
+			{Name: "Comments", IsRelation: true},
+		}},
+		{Name: "Tag", Fields: []*BoilerField{{Name: "Name"}}},
+		// a relation table without columns of its own gets no enum since an enum without values is not valid
+		{Name: "Like", Fields: []*BoilerField{{Name: "Posts", IsRelation: true}}},
+	}
+	expected := "enum SortDirection {\n  ASC\n  DESC\n}\n" +
+		"\nenum PostOrderByField {\n  ID\n  USER_ID\n}\n" +
+		"\ninput PostOrderBy {\n  field: PostOrderByField!\n  direction: SortDirection! = ASC\n}\n" +
+		"\nenum TagOrderByField {\n  NAME\n}\n" +
+		"\ninput TagOrderBy {\n  field: TagOrderByField!\n  direction: SortDirection! = ASC\n}\n"
+	if schema := getOrderBySchema(boilerModels, nil); schema != expected {
+		t.Errorf("schema should be\n%v\nbut is\n%v", expected, schema)
+	}
+
+	// types in your own schema are not added again
+	expected = "\nenum PostOrderByField {\n  ID\n  USER_ID\n}\n" +
+		"\ninput PostOrderBy {\n  field: PostOrderByField!\n  direction: SortDirection! = ASC\n}\n"
+	if schema := getOrderBySchema(boilerModels, map[string]bool{"SortDirection": true, "TagOrderBy": true}); schema != expected {
+		t.Errorf("schema should be\n%v\nbut is\n%v", expected, schema)
+	}
+	if schema := getOrderBySchema(boilerModels[2:], nil); schema != "" {
+		t.Errorf("without columns to sort on there should be no SortDirection but got %v", schema)
+	}
+
+	plugin := &ConvertPlugin{Backend: Config{Directory: blogModelsDirectory}}
+	if source := plugin.InjectSourceEarly(); source != nil {
+		t.Errorf("without generate_order_by nothing should be added to the schema but got %v", source.Input)
+	}
+}
+
+func TestGetDefinedTypes(t *testing.T) {
+	dir := t.TempDir()
+	wd, _ := os.Getwd()
+	defer os.Chdir(wd) //nolint:errcheck
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	writeTemplate(t, "gqlgen.yml", "schema:\n  - schema.graphql\n")
+	writeTemplate(t, "schema.graphql", "enum SortDirection { ASC DESC }\ntype Query { posts(orderBy: PostOrderBy): Int }\n")
+	definedTypes := getDefinedTypes()
+	if !definedTypes["SortDirection"] || !definedTypes["Query"] || definedTypes["PostOrderBy"] {
+		t.Errorf("SortDirection and Query should be defined but got %v", definedTypes)
+	}
+}
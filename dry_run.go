@@ -0,0 +1,57 @@
+package gqlgen_sqlboiler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// ErrNotUpToDate is returned in dry run mode for every file which would change when generating
+var ErrNotUpToDate = errors.New("is not up to date")
+
+// dryRun calls write with a file in a temporary directory instead of the file itself and prints the difference with the
+// file on disk, ErrNotUpToDate is returned when they differ
+func dryRun(filename string, write func(filename string) error) error {
+	return renderInTempDir(func(dir string) error {
+		tempFilename := filepath.Join(dir, filepath.Base(filename))
+		err := write(tempFilename)
+		if _, statErr := os.Stat(tempFilename); statErr != nil {
+			return err
+		}
+		var errs GenerateErrors
+		errs.Append("", err)
+		errs.Append("", printDiff(tempFilename, filename))
+		return errs.ErrorOrNil()
+	})
+}
+
+// printDiff prints a unified diff from the file on disk to the generated file
+func printDiff(generatedFilename, filename string) error {
+	generated, err := readFileIfExists(generatedFilename)
+	if err != nil {
+		return err
+	}
+	current, err := readFileIfExists(filename)
+	if err != nil {
+		return err
+	}
+	if string(generated) == string(current) {
+		return nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(generated)),
+		FromFile: filename,
+		ToFile:   filename + " (generated)",
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Print(diff)
+	return fmt.Errorf("%v %w", filename, ErrNotUpToDate)
+}
@@ -0,0 +1,36 @@
+package gqlgen_sqlboiler
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gqlgen-sqlboiler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "convert.go")
+	if err := ioutil.WriteFile(filename, []byte("package helpers\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	write := func(content string) func(filename string) error {
+		return func(filename string) error {
+			return ioutil.WriteFile(filename, []byte(content), 0600)
+		}
+	}
+	if err := dryRun(filename, write("package helpers\n")); err != nil {
+		t.Errorf("unchanged file should not result in an error but did result in %v", err)
+	}
+	if err := dryRun(filename, write("package helpers\n\nfunc New() {}\n")); !errors.Is(err, ErrNotUpToDate) {
+		t.Errorf("changed file should result in ErrNotUpToDate but did result in %v", err)
+	}
+	if content, _ := ioutil.ReadFile(filename); string(content) != "package helpers\n" {
+		t.Errorf("file should not be written in dry run mode but is %s", content)
+	}
+}
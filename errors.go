@@ -0,0 +1,163 @@
+package gqlgen_sqlboiler
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// GenerateError is a failure while generating, Template, Model and Field are filled in when they are known
+type GenerateError struct {
+	Template string
+	Model    string
+	Field    string
+	Err      error
+}
+
+func (e *GenerateError) Error() string {
+	var location []string
+	if e.Template != "" {
+		location = append(location, e.Template)
+	}
+	if e.Model != "" && e.Field != "" {
+		location = append(location, e.Model+"."+e.Field)
+	} else if e.Model != "" {
+		location = append(location, e.Model)
+	}
+	if len(location) == 0 {
+		return e.Err.Error()
+	}
+	return strings.Join(location, " ") + ": " + e.Err.Error()
+}
+
+func (e *GenerateError) Unwrap() error {
+	return e.Err
+}
+
+// GenerateErrors are all failures of one run, we keep going after a failure so everything which is wrong is reported
+// at once
+type GenerateErrors []*GenerateError
+
+func (errs GenerateErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	if len(messages) == 1 {
+		return messages[0]
+	}
+	return fmt.Sprintf("%d errors while generating:\n\t%s", len(messages), strings.Join(messages, "\n\t"))
+}
+
+// Is makes errors.Is look at every error e.g. errors.Is(err, ErrNotUpToDate)
+func (errs GenerateErrors) Is(target error) bool {
+	for _, err := range errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Append adds the error, errors which are not a GenerateError get the template which failed. Nested errors are
+// flattened, they keep the template, model and field of the errors around them and what those add to the message.
+func (errs *GenerateErrors) Append(template string, err error) {
+	errs.appendNested(GenerateError{Template: template}, "", err)
+}
+
+// appendNested adds the error with the location of the errors around it where it has none itself, prefix is what the
+// errors around it add to the message e.g. "could not render: "
+func (errs *GenerateErrors) appendNested(location GenerateError, prefix string, err error) {
+	if err == nil {
+		return
+	}
+	for wrapped := err; wrapped != nil; wrapped = errors.Unwrap(wrapped) {
+		// an error which does not end with the message of the error it wraps can not be split, it is added as it is
+		if !strings.HasSuffix(err.Error(), wrapped.Error()) {
+			break
+		}
+		wrappedPrefix := prefix + strings.TrimSuffix(err.Error(), wrapped.Error())
+		switch e := wrapped.(type) {
+		case GenerateErrors:
+			for _, nested := range e {
+				errs.appendNested(location, wrappedPrefix, nested)
+			}
+			return
+		case *GenerateError:
+			errs.appendNested(GenerateError{
+				Template: firstNonEmpty(e.Template, location.Template),
+				Model:    firstNonEmpty(e.Model, location.Model),
+				Field:    firstNonEmpty(e.Field, location.Field),
+			}, wrappedPrefix, e.Err)
+			return
+		}
+	}
+	if prefix != "" {
+		err = fmt.Errorf("%v%w", prefix, err)
+	}
+	location.Err = err
+	*errs = append(*errs, &location)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// ErrorOrNil returns nil if nothing failed, otherwise the errors
+func (errs GenerateErrors) ErrorOrNil() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func isTemplateExecError(err error) bool {
+	var execError template.ExecError
+	return errors.As(err, &execError)
+}
+
+// findFailingModels renders every model on its own and the fields of a failing model one by one so we can tell which
+// model and field broke the template, the original error is returned if no single model fails
+func findFailingModels(err error, models []*Model, render func(models []*Model) error) error {
+	var errs GenerateErrors
+	for _, model := range models {
+		modelErr := render([]*Model{model})
+		if !isTemplateExecError(modelErr) {
+			continue
+		}
+		var fieldErrs GenerateErrors
+		for _, field := range model.Fields {
+			attempt := *model
+			attempt.Fields = []*Field{field}
+			if fieldErr := render([]*Model{&attempt}); isTemplateExecError(fieldErr) {
+				fieldErrs = append(fieldErrs, &GenerateError{Model: model.Name, Field: field.Name, Err: fieldErr})
+			}
+		}
+		if len(fieldErrs) == 0 {
+			fieldErrs = append(fieldErrs, &GenerateError{Model: model.Name, Err: modelErr})
+		}
+		errs = append(errs, fieldErrs...)
+	}
+	if len(errs) == 0 {
+		return err
+	}
+	return errs
+}
+
+// renderInTempDir is used to render a template again without touching the generated files
+func renderInTempDir(render func(dir string) error) error {
+	dir, err := ioutil.TempDir("", "gqlgen-sqlboiler")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	return render(dir)
+}
@@ -0,0 +1,153 @@
+package gqlgen_sqlboiler
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestGenerateErrors(t *testing.T) {
+	var errs GenerateErrors
+	if errs.ErrorOrNil() != nil {
+		t.Errorf("no errors should result in nil")
+	}
+
+	errs.Append("convert.gotpl", errors.New("could not read template"))
+	errs.Append("", GenerateErrors{
+		{Model: "Post", Field: "title", Err: errors.New("unknown type")},
+	})
+	errs.Append("filter.gotpl", fmt.Errorf("rendering failed: %w", &GenerateError{Model: "Comment", Err: errors.New("nil")}))
+
+	expected := "3 errors while generating:\n" +
+		"\tconvert.gotpl: could not read template\n" +
+		"\tPost.title: unknown type\n" +
+		"\tfilter.gotpl Comment: rendering failed: nil"
+	if err := errs.ErrorOrNil(); err == nil || err.Error() != expected {
+		t.Errorf("errors should result in %v but did result in %v", expected, err)
+	}
+}
+
+func TestGenerateErrorsNested(t *testing.T) {
+	var errs GenerateErrors
+	errs.Append("resolver.gotpl", &GenerateError{Model: "Post", Err: fmt.Errorf("could not merge: %w", GenerateErrors{
+		{Field: "CreatePost", Err: errors.New("conflict")},
+		{Model: "Comment", Field: "Content", Err: fmt.Errorf("graph/post.go %w", ErrNotUpToDate)},
+	})})
+	errs.Append("convert.gotpl", fmt.Errorf("graph/resolver.go: %w", GenerateErrors{{Err: errors.New("unknown type")}}))
+	errs.Append("preload.gotpl", fmt.Errorf("%w (again)", GenerateErrors{{Model: "Tag", Err: errors.New("nil")}}))
+
+	expected := "4 errors while generating:\n" +
+		"\tresolver.gotpl Post.CreatePost: could not merge: conflict\n" +
+		"\tresolver.gotpl Comment.Content: could not merge: graph/post.go is not up to date\n" +
+		"\tconvert.gotpl: graph/resolver.go: unknown type\n" +
+		"\tpreload.gotpl: Tag: nil (again)"
+	if err := errs.ErrorOrNil(); err == nil || err.Error() != expected {
+		t.Errorf("errors should result in %v but did result in %v", expected, err)
+	}
+	if !errors.Is(errs, ErrNotUpToDate) {
+		t.Errorf("the nested errors should still be found by errors.Is")
+	}
+}
+
+// constraintTestRunner checks the constraintErrorCode and constraintColumns of a database driver, the cases are
+// errors of the driver with the code, table and columns they should result in
+const constraintTestRunner = `
+type constraintTest struct {
+	err     error
+	code    string
+	table   string
+	columns []string
+}
+
+var postLikeFields = InputFields{
+	Table:   "post_likes",
+	Columns: map[string]string{"post_id": "postId", "user_id": "userId", "note": "note"},
+}
+
+func TestConstraintErrors(t *testing.T) {
+	for _, test := range constraintTests {
+		if code := constraintErrorCode(test.err); code != test.code {
+			t.Errorf("%v should have code %v but has %v", test.err, test.code, code)
+		}
+		table, columns := constraintColumns(test.err, postLikeFields)
+		if table != test.table || !reflect.DeepEqual(columns, test.columns) {
+			t.Errorf("%v should be on %q %v but is on %q %v", test.err, test.table, test.columns, table, columns)
+		}
+	}
+}
+`
+
+func testConstraintErrors(t *testing.T, driver DatabaseDriver, names []string, tests string) {
+	src := renderTemplate(t, "errors.gotpl", &ModelBuild{PluginConfig: ConvertPluginConfig{DatabaseDriver: driver}})
+	names = append(names, "ErrorCodeNotFound", "InputFields", "splitColumns", "constraintErrorCode",
+		"constraintColumns")
+	testGeneratedCode(t, src, names, constraintTestRunner+tests)
+}
+
+func TestPostgresConstraintErrors(t *testing.T) {
+	testConstraintErrors(t, Postgres, []string{"postgresColumn"}, `
+// pqError is like the errors of lib/pq and pgx which have the SQLSTATE
+type pqError struct {
+	code    string
+	message string
+}
+
+func (e pqError) Error() string    { return "pq: " + e.message }
+func (e pqError) SQLState() string { return e.code }
+
+var constraintTests = []constraintTest{
+	{pqError{"23505", "duplicate key value violates unique constraint \"post_likes_post_id_user_id_key\""},
+		"CONFLICT", "", []string{"post_id", "user_id"}},
+	{pqError{"23505", "duplicate key value violates unique constraint \"post_likes_note_key\""},
+		"CONFLICT", "", []string{"note"}},
+	{pqError{"23505", "duplicate key value violates unique constraint \"users_email_key\""},
+		"CONFLICT", "", nil},
+	{pqError{"23502", "null value in column \"note\" violates not-null constraint"},
+		"VALIDATION", "", []string{"note"}},
+	{pqError{"23502", "null value in column \"note\" of relation \"post_likes\" violates not-null constraint"},
+		"VALIDATION", "post_likes", []string{"note"}},
+	{pqError{"23503", "insert or update on table \"post_likes\" violates foreign key constraint \"post_likes_user_id_fkey\""},
+		"VALIDATION", "post_likes", []string{"user_id"}},
+	{pqError{"23514", "new row for relation \"post_likes\" violates check constraint \"post_likes_note_check\""},
+		"VALIDATION", "post_likes", []string{"note"}},
+	{pqError{"40001", "could not serialize access due to concurrent update"}, "INTERNAL", "", nil},
+	{errors.New("sql: connection is already closed"), "INTERNAL", "", nil},
+}
+`)
+}
+
+func TestMySQLConstraintErrors(t *testing.T) {
+	testConstraintErrors(t, MySQL, []string{"mysqlErrorNumber"}, `
+var constraintTests = []constraintTest{
+	{errors.New("Error 1062: Duplicate entry '1-2' for key 'post_id_user_id'"),
+		"CONFLICT", "", []string{"post_id", "user_id"}},
+	{errors.New("Error 1062 (23000): Duplicate entry '1-2' for key 'post_likes.post_id_user_id'"),
+		"CONFLICT", "post_likes", []string{"post_id", "user_id"}},
+	{errors.New("Error 1062: Duplicate entry 'a' for key 'note'"), "CONFLICT", "", []string{"note"}},
+	{errors.New("Error 1048: Column 'note' cannot be null"), "VALIDATION", "", []string{"note"}},
+	{errors.New("Error 1452: Cannot add or update a child row: a foreign key constraint fails `+
+		"(`blog`.`post_likes`, CONSTRAINT `post_likes_ibfk_2` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`))"+`"),
+		"VALIDATION", "post_likes", []string{"user_id"}},
+	{errors.New("Error 1452: Cannot add or update a child row: a foreign key constraint fails `+
+		"(`blog`.`post_likes`, CONSTRAINT `post_likes_ibfk_1` FOREIGN KEY (`post_id`, `user_id`) REFERENCES `x` (`a`, `b`))"+`"),
+		"VALIDATION", "post_likes", []string{"post_id", "user_id"}},
+	{errors.New("Error 3819: Check constraint 'post_likes_note' is violated."), "VALIDATION", "", []string{"note"}},
+	{errors.New("Error 1213: Deadlock found when trying to get lock"), "INTERNAL", "", nil},
+	{errors.New("invalid connection"), "INTERNAL", "", nil},
+}
+`)
+}
+
+func TestSQLiteConstraintErrors(t *testing.T) {
+	testConstraintErrors(t, SQLite, []string{"sqliteConstraint"}, `
+var constraintTests = []constraintTest{
+	{errors.New("UNIQUE constraint failed: post_likes.post_id, post_likes.user_id"),
+		"CONFLICT", "post_likes", []string{"post_id", "user_id"}},
+	{errors.New("UNIQUE constraint failed: users.email"), "CONFLICT", "users", []string{"email"}},
+	{errors.New("NOT NULL constraint failed: post_likes.note"), "VALIDATION", "post_likes", []string{"note"}},
+	{errors.New("FOREIGN KEY constraint failed"), "VALIDATION", "", nil},
+	{errors.New("CHECK constraint failed: post_likes_note"), "VALIDATION", "", []string{"note"}},
+	{errors.New("database is locked"), "INTERNAL", "", nil},
+}
+`)
+}
@@ -0,0 +1,146 @@
+package gqlgen_sqlboiler
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+	"golang.org/x/tools/imports"
+)
+
+// renderTemplate renders e.g. convert.gotpl like the convert plugin does and returns the generated code
+func renderTemplate(t *testing.T, filename string, data interface{}) []byte {
+	t.Helper()
+	// gqlgen looks up the name of every import, the modules of the generated code should not be added to go.mod
+	t.Setenv("GOFLAGS", "-mod=readonly")
+	// the packages cache of gqlgen is internal so it is created like config.Init would
+	cfg := config.DefaultConfig()
+	packagesField := reflect.ValueOf(cfg).Elem().FieldByName("Packages")
+	packagesField.Set(reflect.New(packagesField.Type().Elem()))
+	template, err := getTemplate(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(t.TempDir(), "generated.go")
+	templates.CurrentImports = nil
+	if err := templates.Render(templates.Options{
+		Template:    template,
+		PackageName: "helpers",
+		Filename:    output,
+		Data:        data,
+		Packages:    cfg.Packages,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	src, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+// renderPartial executes one partial of the template e.g. {{ define "withDeleted" }} of resolver.gotpl, the
+// whitespace of the result is collapsed so it can be compared with a single line
+func renderPartial(t *testing.T, filename, name string, data interface{}) string {
+	t.Helper()
+	source, err := getTemplate(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl, err := template.New(filename).Funcs(templates.Funcs()).Parse(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := tpl.ExecuteTemplate(&b, name, data); err != nil {
+		t.Fatal(err)
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// testGeneratedCode copies the declarations with the names out of the generated code to a new package and runs the
+// test there, the declarations and the test can only use the standard library
+func testGeneratedCode(t *testing.T, src []byte, names []string, test string) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("runs go test on the generated code")
+	}
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "generated.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+	var code bytes.Buffer
+	code.WriteString("package helpers\n\n")
+	for _, decl := range file.Decls {
+		if !wanted[declarationName(decl)] {
+			continue
+		}
+		delete(wanted, declarationName(decl))
+		if err := printer.Fprint(&code, fileSet, decl); err != nil {
+			t.Fatal(err)
+		}
+		code.WriteString("\n\n")
+	}
+	for name := range wanted {
+		t.Fatalf("%v is not generated", name)
+	}
+
+	dir := t.TempDir()
+	writeGoFile(t, filepath.Join(dir, "generated.go"), code.Bytes())
+	writeGoFile(t, filepath.Join(dir, "generated_test.go"), []byte("package helpers\n\n"+test))
+	if err := ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module helpers\n\ngo 1.16\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	command := exec.Command("go", "test", ".")
+	command.Dir = dir
+	if output, err := command.CombinedOutput(); err != nil {
+		t.Errorf("generated code fails: %v\n%s\n%s", err, output, code.Bytes())
+	}
+}
+
+// declarationName returns the name of a function, type or the first var or const of the declaration
+func declarationName(decl ast.Decl) string {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		return decl.Name.Name
+	case *ast.GenDecl:
+		if len(decl.Specs) == 0 {
+			return ""
+		}
+		switch spec := decl.Specs[0].(type) {
+		case *ast.TypeSpec:
+			return spec.Name.Name
+		case *ast.ValueSpec:
+			return spec.Names[0].Name
+		}
+	}
+	return ""
+}
+
+// writeGoFile adds the missing imports of the standard library
+func writeGoFile(t *testing.T, filename string, src []byte) {
+	t.Helper()
+	src, err := imports.Process(filename, src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, src, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -10,13 +10,12 @@ import (
 	"golang.org/x/mod/modfile"
 )
 
-func getRootImportPath() string {
+func getRootImportPath() (string, error) {
 	importPath, err := rootImportPath()
 	if err != nil {
-		fmt.Printf("error while getting root import path %v", err)
-		return ""
+		return "", fmt.Errorf("error while getting root import path %w", err)
 	}
-	return importPath
+	return importPath, nil
 }
 
 func rootImportPath() (string, error) {
@@ -85,7 +85,7 @@ type Rewriter struct {
 
 func NewRewriter(importPath string) (*Rewriter, error) {
 	pkgs, err := packages.Load(&packages.Config{
-		Mode: packages.NeedSyntax | packages.NeedTypes,
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedSyntax,
 	}, importPath)
 	if err != nil {
 		return nil, err
@@ -161,6 +161,43 @@ func (r *Rewriter) GetMethodBody(structname string, methodname string) string {
 	return ""
 }
 
+// ImplementedMethods returns the methods per struct e.g. queryResolver.Posts which are declared outside the given
+// files, mapped to the file they are declared in
+func (r *Rewriter) ImplementedMethods(excludeFilenames ...string) (map[string]string, error) {
+	excluded := map[string]bool{}
+	for _, filename := range excludeFilenames {
+		filename, err := filepath.Abs(filename)
+		if err != nil {
+			return nil, err
+		}
+		excluded[filename] = true
+	}
+
+	methods := map[string]string{}
+	for _, f := range r.pkg.Syntax {
+		filename := r.pkg.Fset.Position(f.Pos()).Filename
+		if excluded[filename] {
+			continue
+		}
+		for _, d := range f.Decls {
+			d, isFunc := d.(*ast.FuncDecl)
+			if !isFunc || d.Recv == nil || len(d.Recv.List) == 0 {
+				continue
+			}
+			recv := d.Recv.List[0].Type
+			if star, isStar := recv.(*ast.StarExpr); isStar {
+				recv = star.X
+			}
+			ident, ok := recv.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			methods[ident.Name+"."+d.Name.Name] = filename
+		}
+	}
+	return methods, nil
+}
+
 func (r *Rewriter) MarkStructCopied(name string) {
 	for _, f := range r.pkg.Syntax {
 		for _, d := range f.Decls {
@@ -186,6 +223,23 @@ func (r *Rewriter) MarkStructCopied(name string) {
 	}
 }
 
+// MarkConstCopied prevents a generated constant from ending up in the remaining source
+func (r *Rewriter) MarkConstCopied(name string) {
+	for _, f := range r.pkg.Syntax {
+		for _, d := range f.Decls {
+			d, isGen := d.(*ast.GenDecl)
+			if !isGen || d.Tok != token.CONST || len(d.Specs) != 1 {
+				continue
+			}
+			spec, isValueSpec := d.Specs[0].(*ast.ValueSpec)
+			if !isValueSpec || len(spec.Names) != 1 || spec.Names[0].Name != name {
+				continue
+			}
+			r.copied[d] = true
+		}
+	}
+}
+
 func (r *Rewriter) ExistingImports(filename string) []Import {
 	filename, err := filepath.Abs(filename)
 	if err != nil {
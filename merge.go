@@ -0,0 +1,366 @@
+package gqlgen_sqlboiler
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// mergeCacheDirectory holds the previous generated version of a file next to it, the go tool ignores directories which
+// start with a dot
+const mergeCacheDirectory = ".gqlgen-sqlboiler"
+
+func mergeCacheFilename(filename string) string {
+	return filepath.Join(filepath.Dir(filename), mergeCacheDirectory, filepath.Base(filename))
+}
+
+func readFileIfExists(filename string) ([]byte, error) {
+	b, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return b, err
+}
+
+// mergeWithPrevious is called after the file has been generated again, the changes you made to the previous generated
+// version are merged into it per function, type, const and var. When you and the generator both changed the same
+// declaration both versions are written with conflict markers and a GenerateError is returned for each of them.
+func mergeWithPrevious(filename string, current, previous []byte) error {
+	generated, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("could not read generated file: %w", err)
+	}
+
+	merged := generated
+	var conflicts []string
+	if current != nil && previous == nil && !bytes.Equal(current, generated) {
+		return writeNewVersion(filename, current, generated)
+	}
+	if current != nil && previous != nil && !bytes.Equal(current, previous) {
+		merged, conflicts, err = mergeThreeWay(previous, current, generated)
+		if err != nil {
+			// put your version back so nothing is lost
+			_ = ioutil.WriteFile(filename, current, 0644) //nolint:gosec
+			return &GenerateError{
+				Template: filepath.Base(filename),
+				Err:      fmt.Errorf("could not merge your changes, %v is left as it was: %w", filename, err),
+			}
+		}
+		if err := ioutil.WriteFile(filename, merged, 0644); err != nil { //nolint:gosec
+			return err
+		}
+	}
+
+	cacheFilename := mergeCacheFilename(filename)
+	if err := os.MkdirAll(filepath.Dir(cacheFilename), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(cacheFilename, generated, 0644); err != nil { //nolint:gosec
+		return err
+	}
+
+	var errs GenerateErrors
+	for _, conflict := range conflicts {
+		model, field := PkgAndType(conflict)
+		if model == "" {
+			model, field = field, ""
+		}
+		errs = append(errs, &GenerateError{
+			Template: filepath.Base(filename),
+			Model:    model,
+			Field:    field,
+			Err:      fmt.Errorf("your changes conflict with the generated code, resolve the conflict in %v", filename),
+		})
+	}
+	return errs.ErrorOrNil()
+}
+
+// writeNewVersion is used when there is no previous generated version to merge your changes with, e.g. when the
+// .gqlgen-sqlboiler directory was deleted. Your file is left as it is and the generated version is written next to it
+// with a .new extension so you can merge it yourself, the next time your changes are merged with it.
+func writeNewVersion(filename string, current, generated []byte) error {
+	newFilename := filename + ".new"
+	if err := ioutil.WriteFile(newFilename, generated, 0644); err != nil { //nolint:gosec
+		return err
+	}
+	if err := ioutil.WriteFile(filename, current, 0644); err != nil { //nolint:gosec
+		return err
+	}
+	cacheFilename := mergeCacheFilename(filename)
+	if err := os.MkdirAll(filepath.Dir(cacheFilename), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(cacheFilename, generated, 0644); err != nil { //nolint:gosec
+		return err
+	}
+	fmt.Printf("[resolver] %v is not overwritten since the previous generated version is missing, merge %v into it\n",
+		filename, filepath.Base(newFilename))
+	return &GenerateError{
+		Template: filepath.Base(filename),
+		Err: fmt.Errorf("could not merge your changes without %v, the generated code is written to %v",
+			cacheFilename, newFilename),
+	}
+}
+
+type sourceDecl struct {
+	key      string
+	source   string
+	start    int
+	end      int
+	isImport bool
+}
+
+type sourceFile struct {
+	src   []byte
+	decls []*sourceDecl
+	byKey map[string]*sourceDecl
+	specs []*ast.ImportSpec
+}
+
+// mergeThreeWay merges the changes between previous and current into generated, it returns the keys e.g.
+// queryResolver.Posts of the declarations which are changed by you and by the generator
+func mergeThreeWay(previous, current, generated []byte) ([]byte, []string, error) {
+	base, err := parseSourceFile(previous)
+	if err != nil {
+		return nil, nil, fmt.Errorf("previous generated version: %w", err)
+	}
+	yours, err := parseSourceFile(current)
+	if err != nil {
+		return nil, nil, err
+	}
+	theirs, err := parseSourceFile(generated)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generated version: %w", err)
+	}
+
+	var conflicts []string
+	var buf bytes.Buffer
+	offset := 0
+	addedImports := missingImports(yours.specs, theirs.specs)
+	importsWritten := false
+	for _, decl := range theirs.decls {
+		buf.Write(theirs.src[offset:decl.start])
+		offset = decl.end
+		if decl.isImport {
+			// your imports are added to the first import declaration which then holds every import
+			switch {
+			case len(addedImports) == 0:
+				buf.WriteString(decl.source)
+			case !importsWritten:
+				buf.WriteString(addImports(decl.source, theirs.specs, addedImports))
+			}
+			importsWritten = true
+			continue
+		}
+
+		baseDecl, yourDecl := base.byKey[decl.key], yours.byKey[decl.key]
+		switch {
+		case yourDecl == nil && baseDecl == nil, sameSource(yourDecl, baseDecl), sameSource(yourDecl, decl):
+			buf.WriteString(decl.source)
+		case yourDecl == nil && sameSource(decl, baseDecl):
+			// you deleted it and the generator did not change it
+			offset = skipBlankLines(theirs.src, offset)
+		case yourDecl == nil:
+			conflicts = append(conflicts, decl.key)
+			buf.WriteString("<<<<<<< your changes\n=======\n" + decl.source + "\n>>>>>>> generated")
+		case sameSource(decl, baseDecl):
+			buf.WriteString(yourDecl.source)
+		default:
+			conflicts = append(conflicts, decl.key)
+			buf.WriteString("<<<<<<< your changes\n" + yourDecl.source + "\n=======\n" + decl.source +
+				"\n>>>>>>> generated")
+		}
+	}
+	buf.Write(theirs.src[offset:])
+
+	// declarations which you added or changed and which are not generated anymore are kept at the end
+	for _, decl := range yours.decls {
+		if decl.isImport || theirs.byKey[decl.key] != nil || sameSource(decl, base.byKey[decl.key]) {
+			continue
+		}
+		buf.WriteString("\n" + decl.source + "\n")
+	}
+
+	if len(conflicts) > 0 {
+		return buf.Bytes(), conflicts, nil
+	}
+	merged, err := pruneImports(buf.Bytes(), addedImports)
+	return merged, nil, err
+}
+
+// skipBlankLines returns the offset after the newlines which follow offset so a deleted declaration leaves no gap
+func skipBlankLines(src []byte, offset int) int {
+	for offset < len(src) && src[offset] == '\n' {
+		offset++
+	}
+	return offset
+}
+
+func parseSourceFile(src []byte) (*sourceFile, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &sourceFile{src: src, byKey: map[string]*sourceDecl{}, specs: file.Imports}
+	for _, d := range file.Decls {
+		start := d.Pos()
+		var key string
+		switch d := d.(type) {
+		case *ast.FuncDecl:
+			if d.Doc != nil {
+				start = d.Doc.Pos()
+			}
+			key = d.Name.Name
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				recv := d.Recv.List[0].Type
+				if star, isStar := recv.(*ast.StarExpr); isStar {
+					recv = star.X
+				}
+				if ident, ok := recv.(*ast.Ident); ok {
+					key = ident.Name + "." + key
+				}
+			}
+		case *ast.GenDecl:
+			if d.Doc != nil {
+				start = d.Doc.Pos()
+			}
+			key = d.Tok.String() + " " + strings.Join(getSpecNames(d), ",")
+		default:
+			continue
+		}
+
+		// declarations with the same key e.g. init functions get a number
+		for i := 2; f.byKey[key] != nil; i++ {
+			key = fmt.Sprintf("%v#%v", strings.Split(key, "#")[0], i)
+		}
+		decl := &sourceDecl{
+			key:   key,
+			start: fset.Position(start).Offset,
+			end:   fset.Position(d.End()).Offset,
+		}
+		decl.source = string(src[decl.start:decl.end])
+		if d, isGen := d.(*ast.GenDecl); isGen && d.Tok == token.IMPORT {
+			decl.isImport = true
+		}
+		f.decls = append(f.decls, decl)
+		f.byKey[key] = decl
+	}
+	return f, nil
+}
+
+func getSpecNames(d *ast.GenDecl) []string {
+	var names []string
+	for _, spec := range d.Specs {
+		switch spec := spec.(type) {
+		case *ast.TypeSpec:
+			names = append(names, spec.Name.Name)
+		case *ast.ValueSpec:
+			for _, name := range spec.Names {
+				names = append(names, name.Name)
+			}
+		case *ast.ImportSpec:
+			names = append(names, spec.Path.Value)
+		}
+	}
+	return names
+}
+
+func sameSource(a, b *sourceDecl) bool {
+	return a != nil && b != nil && strings.TrimSpace(a.source) == strings.TrimSpace(b.source)
+}
+
+// missingImports returns the imports of your file which the generated file does not have
+func missingImports(yours, generated []*ast.ImportSpec) []*ast.ImportSpec {
+	imported := map[string]bool{}
+	for _, spec := range generated {
+		imported[spec.Path.Value] = true
+	}
+	var missing []*ast.ImportSpec
+	for _, spec := range yours {
+		if !imported[spec.Path.Value] {
+			missing = append(missing, spec)
+		}
+	}
+	return missing
+}
+
+// addImports adds your imports at the start of the import declaration, gofmt sorts them afterwards
+func addImports(source string, generated, added []*ast.ImportSpec) string {
+	var b strings.Builder
+	for _, spec := range added {
+		b.WriteString("\t")
+		if spec.Name != nil {
+			b.WriteString(spec.Name.Name + " ")
+		}
+		b.WriteString(spec.Path.Value + "\n")
+	}
+	if i := strings.Index(source, "(\n"); i != -1 {
+		return source[:i+2] + b.String() + source[i+2:]
+	}
+	return addImports("import (\n)", nil, append(append([]*ast.ImportSpec{}, generated...), added...))
+}
+
+var versionSuffixPattern = regexp.MustCompile(`^v[0-9]+$`) //nolint:gochecknoglobals
+
+// importName guesses the name of an imported package, the generated imports are never removed so a wrong guess can
+// only remove one of your own imports
+func importName(importPath string) string {
+	name := path.Base(importPath)
+	if versionSuffixPattern.MatchString(name) {
+		name = path.Base(path.Dir(importPath))
+	}
+	name = strings.TrimPrefix(name, "go-")
+	return SanitizePackageName(strings.Split(name, ".")[0])
+}
+
+// pruneImports removes the imports of your file which are not used anymore after merging and formats the result
+func pruneImports(src []byte, added []*ast.ImportSpec) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[string]bool{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok && ident.Obj == nil {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	for _, spec := range added {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		name, alias := importName(importPath), ""
+		if spec.Name != nil {
+			name, alias = spec.Name.Name, spec.Name.Name
+		}
+		if name != "_" && name != "." && !used[name] {
+			astutil.DeleteNamedImport(fset, file, alias, importPath)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,109 @@
+package gqlgen_sqlboiler
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const mergeBase = `package graph
+
+import "fmt"
+
+func (r *queryResolver) A() string { return fmt.Sprint("a") }
+
+func (r *queryResolver) B() string { return "b" }
+
+func (r *queryResolver) C() string { return "c" }
+`
+
+func TestMergeThreeWay(t *testing.T) {
+	yours := `package graph
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (r *queryResolver) A() string { return strings.ToUpper(fmt.Sprint("a")) }
+
+func (r *queryResolver) B() string { return "b" }
+
+func (r *queryResolver) C() string { return "c" }
+
+func shout(s string) string { return s + "!" }
+`
+	generated := strings.Replace(mergeBase, `return "b"`, `return "generated b"`, 1)
+
+	merged, conflicts, err := mergeThreeWay([]byte(mergeBase), []byte(yours), []byte(generated))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 0 {
+		t.Errorf("there should be no conflicts but there are %v", conflicts)
+	}
+	for _, expected := range []string{`"strings"`, `strings.ToUpper(fmt.Sprint("a"))`, `return "generated b"`,
+		`return "c"`, `func shout(`} {
+		if !strings.Contains(string(merged), expected) {
+			t.Errorf("merged file should contain %v but is\n%s", expected, merged)
+		}
+	}
+
+	yours = strings.Replace(mergeBase, `return "c"`, `return "your c"`, 1)
+	generated = strings.Replace(mergeBase, `return "c"`, `return "generated c"`, 1)
+	merged, conflicts, err = mergeThreeWay([]byte(mergeBase), []byte(yours), []byte(generated))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 1 || conflicts[0] != "queryResolver.C" {
+		t.Errorf("queryResolver.C should conflict but conflicts are %v", conflicts)
+	}
+	if !strings.Contains(string(merged), "<<<<<<< your changes") || !strings.Contains(string(merged), `"your c"`) ||
+		!strings.Contains(string(merged), `"generated c"`) {
+		t.Errorf("merged file should contain both versions of C but is\n%s", merged)
+	}
+}
+
+func TestMergeThreeWayKeepsDeletions(t *testing.T) {
+	yours := strings.Replace(mergeBase, "func (r *queryResolver) B() string { return \"b\" }\n", "", 1)
+	merged, conflicts, err := mergeThreeWay([]byte(mergeBase), []byte(yours), []byte(mergeBase))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 0 || strings.Contains(string(merged), "B()") {
+		t.Errorf("B should stay deleted without conflicts but conflicts are %v and merged file is\n%s", conflicts, merged)
+	}
+
+	generated := strings.Replace(mergeBase, `return "b"`, `return "generated b"`, 1)
+	merged, conflicts, err = mergeThreeWay([]byte(mergeBase), []byte(yours), []byte(generated))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 1 || conflicts[0] != "queryResolver.B" || !strings.Contains(string(merged), `"generated b"`) {
+		t.Errorf("B should conflict since the generator changed it but conflicts are %v and merged file is\n%s",
+			conflicts, merged)
+	}
+}
+
+func TestMergeWithoutPrevious(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "resolver.go")
+	yours := strings.Replace(mergeBase, `return "c"`, `return "your c"`, 1)
+	generated := strings.Replace(mergeBase, `return "b"`, `return "generated b"`, 1)
+	if err := ioutil.WriteFile(filename, []byte(generated), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mergeWithPrevious(filename, []byte(yours), nil); err == nil {
+		t.Errorf("merging without the previous generated version should fail")
+	}
+	if b, _ := ioutil.ReadFile(filename); string(b) != yours {
+		t.Errorf("your file should be left as it is but is\n%s", b)
+	}
+	if b, _ := ioutil.ReadFile(filename + ".new"); string(b) != generated {
+		t.Errorf("the generated version should be written to resolver.go.new but it is\n%s", b)
+	}
+	if b, _ := ioutil.ReadFile(mergeCacheFilename(filename)); string(b) != generated {
+		t.Errorf("the generated version should be cached to merge with the next time but it is\n%s", b)
+	}
+}
@@ -0,0 +1,151 @@
+package gqlgen_sqlboiler
+
+import "testing"
+
+// paginationDeclarations are the parts of pagination.gotpl which only use the standard library
+var paginationDeclarations = []string{
+	"DefaultConnectionLimit", "MaxConnectionLimit", "nullsFirst", "ConnectionPagination", "IsBackward", "Limit",
+	"Validate", "keysetCondition", "comparison", "isNullValue", "encodeCursor", "decodeCursor",
+}
+
+// paginationTestHelpers declares what pagination.gotpl uses from filter.gotpl and errors.gotpl
+const paginationTestHelpers = `
+var ErrInvalidPagination = errors.New("invalid pagination")
+
+type SortColumn struct {
+	Name       string
+	Descending bool
+}
+
+func quoteColumn(column string) string {
+	return column
+}
+
+type post struct {
+	ID        int64
+	Title     string
+	DeletedAt sql.NullTime
+	CreatedAt time.Time
+}
+
+func postCursorValue(column string) interface{} {
+	var m post
+	switch column {
+	case "id":
+		return &m.ID
+	case "title":
+		return &m.Title
+	case "deleted_at":
+		return &m.DeletedAt
+	case "created_at":
+		return &m.CreatedAt
+	}
+	return nil
+}
+
+func testKeysetCondition(t *testing.T, columns []SortColumn, values []interface{}, before bool, expected string, expectedArgs ...interface{}) {
+	t.Helper()
+	condition, args := keysetCondition(columns, values, before)
+	if condition != expected || !reflect.DeepEqual(args, expectedArgs) {
+		t.Errorf("condition should be %v %v but is %v %v", expected, expectedArgs, condition, args)
+	}
+}
+`
+
+func renderPagination(t *testing.T, driver DatabaseDriver) []byte {
+	return renderTemplate(t, "pagination.gotpl", &ModelBuild{
+		Backend:      Config{Directory: "github.com/yourname/app/models", PackageName: "models"},
+		Frontend:     Config{Directory: "github.com/yourname/app/graphql_models", PackageName: "fm"},
+		PluginConfig: ConvertPluginConfig{DatabaseDriver: driver},
+	})
+}
+
+func TestPaginationCursor(t *testing.T) {
+	testGeneratedCode(t, renderPagination(t, Postgres), paginationDeclarations, paginationTestHelpers+`
+func TestCursor(t *testing.T) {
+	columns := []SortColumn{{Name: "created_at"}, {Name: "deleted_at"}, {Name: "title"}, {Name: "id"}}
+	createdAt := time.Date(2020, 10, 18, 12, 30, 15, 123456789, time.FixedZone("CEST", 2*60*60))
+	expected := []interface{}{createdAt, sql.NullTime{}, "post", int64(1<<53 + 1)}
+	values, err := decodeCursor(encodeCursor(expected), columns, postCursorValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !values[0].(time.Time).Equal(createdAt) || values[1] != expected[1] || values[2] != expected[2] ||
+		values[3] != expected[3] {
+		t.Errorf("cursor values should be %v but are %v", expected, values)
+	}
+
+	cursor := encodeCursor([]interface{}{int64(1)})
+	for _, test := range []struct {
+		cursor  string
+		columns []SortColumn
+	}{
+		{"???", columns[3:]},
+		{encodeCursor([]interface{}{"1"}), columns[3:]},
+		{cursor, columns},
+		{cursor, []SortColumn{{Name: "password"}}},
+	} {
+		if _, err := decodeCursor(test.cursor, test.columns, postCursorValue); !errors.Is(err, ErrInvalidPagination) {
+			t.Errorf("cursor %v for %v should not be valid but got %v", test.cursor, test.columns, err)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	zero, negative, tooMany := 0, -1, MaxConnectionLimit+1
+	for _, pagination := range []ConnectionPagination{{}, {First: &zero}, {Last: &MaxConnectionLimit}} {
+		if err := pagination.Validate(); err != nil {
+			t.Errorf("%+v should be valid but got %v", pagination, err)
+		}
+	}
+	for _, pagination := range []ConnectionPagination{{First: &negative}, {Last: &negative}, {First: &tooMany}, {Last: &tooMany}} {
+		if err := pagination.Validate(); !errors.Is(err, ErrInvalidPagination) {
+			t.Errorf("%+v should not be valid but got %v", pagination, err)
+		}
+	}
+}
+`)
+}
+
+func TestPaginationKeysetCondition(t *testing.T) {
+	// postgres sorts NULL last in ascending order
+	testGeneratedCode(t, renderPagination(t, Postgres), paginationDeclarations, paginationTestHelpers+`
+func TestKeysetCondition(t *testing.T) {
+	columns := []SortColumn{{Name: "title", Descending: true}, {Name: "id"}}
+	testKeysetCondition(t, columns, []interface{}{"a", int64(1)}, false,
+		"((title < ?) OR (title = ? AND id > ?))", "a", "a", int64(1))
+	testKeysetCondition(t, columns, []interface{}{"a", int64(1)}, true,
+		"((title > ?) OR (title = ? AND id < ?))", "a", "a", int64(1))
+
+	deletedAt := sql.NullTime{Time: time.Unix(1, 0), Valid: true}
+	columns = []SortColumn{{Name: "deleted_at"}, {Name: "id"}}
+	testKeysetCondition(t, columns, []interface{}{deletedAt, int64(1)}, false,
+		"(((deleted_at > ? OR deleted_at IS NULL)) OR (deleted_at = ? AND id > ?))", deletedAt, deletedAt, int64(1))
+	testKeysetCondition(t, columns, []interface{}{deletedAt, int64(1)}, true,
+		"((deleted_at < ?) OR (deleted_at = ? AND id < ?))", deletedAt, deletedAt, int64(1))
+	testKeysetCondition(t, columns, []interface{}{sql.NullTime{}, int64(1)}, false,
+		"((deleted_at IS NULL AND id > ?))", int64(1))
+	testKeysetCondition(t, columns, []interface{}{sql.NullTime{}, int64(1)}, true,
+		"((deleted_at IS NOT NULL) OR (deleted_at IS NULL AND id < ?))", int64(1))
+
+	columns = []SortColumn{{Name: "deleted_at", Descending: true}, {Name: "id"}}
+	testKeysetCondition(t, columns, []interface{}{sql.NullTime{}, int64(1)}, false,
+		"((deleted_at IS NOT NULL) OR (deleted_at IS NULL AND id > ?))", int64(1))
+}
+`)
+	// mysql and sqlite sort NULL first in ascending order
+	testGeneratedCode(t, renderPagination(t, SQLite), paginationDeclarations, paginationTestHelpers+`
+func TestKeysetCondition(t *testing.T) {
+	deletedAt := sql.NullTime{Time: time.Unix(1, 0), Valid: true}
+	columns := []SortColumn{{Name: "deleted_at"}, {Name: "id"}}
+	testKeysetCondition(t, columns, []interface{}{deletedAt, int64(1)}, false,
+		"((deleted_at > ?) OR (deleted_at = ? AND id > ?))", deletedAt, deletedAt, int64(1))
+	testKeysetCondition(t, columns, []interface{}{deletedAt, int64(1)}, true,
+		"(((deleted_at < ? OR deleted_at IS NULL)) OR (deleted_at = ? AND id < ?))", deletedAt, deletedAt, int64(1))
+	testKeysetCondition(t, columns, []interface{}{sql.NullTime{}, int64(1)}, false,
+		"((deleted_at IS NOT NULL) OR (deleted_at IS NULL AND id > ?))", int64(1))
+	testKeysetCondition(t, columns, []interface{}{nil, int64(1)}, true,
+		"((deleted_at IS NULL AND id < ?))", int64(1))
+}
+`)
+}
@@ -0,0 +1,191 @@
+package gqlgen_sqlboiler
+
+import (
+	"fmt"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/99designs/gqlgen/plugin"
+	"gopkg.in/yaml.v2"
+)
+
+// PluginConfigFilenames are the files LoadPluginConfigFromDefaultLocations looks for. gqlgen.yml itself can not be
+// used since gqlgen does not allow unknown keys in it.
+var PluginConfigFilenames = []string{"gqlgen-sqlboiler.yml", "gqlgen-sqlboiler.yaml"} //nolint:gochecknoglobals
+
+// PluginConfig configures the convert and resolver plugin, e.g.
+//
+//	sqlboiler:
+//	  output:
+//	    directory: helpers
+//	  backend:
+//	    directory: models
+//	  frontend:
+//	    directory: graphql_models
+//	  auth_import: github.com/yourname/app/auth
+//	  database_driver: postgres
+//	  generate_order_by: true
+//	  template_directory: templates
+//	  dry_run: false
+//	  scopes:
+//	    - column: tenant_id
+//	      accessor: auth.TenantIDFromContext
+type PluginConfig struct {
+	Output     Config `yaml:"output"`
+	Backend    Config `yaml:"backend"`
+	Frontend   Config `yaml:"frontend"`
+	AuthImport string `yaml:"auth_import"`
+	// Scopes replace the default organization_id, user_organization_id and user_id scopes of the auth_import
+	Scopes []*Scope `yaml:"scopes"`
+
+	ConvertPluginConfig `yaml:",inline"`
+}
+
+type pluginConfigFile struct {
+	SQLBoiler *PluginConfig `yaml:"sqlboiler"`
+}
+
+// LoadPluginConfigFromDefaultLocations looks for gqlgen-sqlboiler.yml in the current directory and all parent
+// directories, the closest one is used.
+func LoadPluginConfigFromDefaultLocations() (*PluginConfig, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting working directory %w", err)
+	}
+	for {
+		for _, filename := range PluginConfigFilenames {
+			path := filepath.Join(dir, filename)
+			if _, err := os.Stat(path); err == nil {
+				return LoadPluginConfig(path)
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, fmt.Errorf("could not find %v in the current directory or any parent directory",
+				strings.Join(PluginConfigFilenames, " or "))
+		}
+		dir = parent
+	}
+}
+
+// LoadPluginConfig reads and validates the sqlboiler section of the file, relative directories are relative to the
+// directory of the file.
+func LoadPluginConfig(filename string) (*PluginConfig, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %v: %w", filename, err)
+	}
+	var file pluginConfigFile
+	if err := yaml.UnmarshalStrict(b, &file); err != nil {
+		return nil, fmt.Errorf("unable to parse %v: %w", filename, err)
+	}
+	if file.SQLBoiler == nil {
+		return nil, fmt.Errorf("%v has no sqlboiler section", filename)
+	}
+	cfg := file.SQLBoiler
+	cfg.setDefaults()
+	if err := cfg.resolveDirectories(filepath.Dir(filename)); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %v: %w", filename, err)
+	}
+	return cfg, nil
+}
+
+func (c *PluginConfig) setDefaults() {
+	for _, dirConfig := range []*Config{&c.Output, &c.Backend, &c.Frontend} {
+		if dirConfig.PackageName == "" && dirConfig.Directory != "" {
+			dirConfig.PackageName = SanitizePackageName(dirConfig.Directory)
+		}
+	}
+	if c.DatabaseDriver == "" {
+		c.DatabaseDriver = Postgres
+	}
+}
+
+// resolveDirectories makes the relative directories of the configuration file relative to the working directory, the
+// generated code is written there and the import paths are based on it
+func (c *PluginConfig) resolveDirectories(configDirectory string) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error while getting working directory %w", err)
+	}
+	configDirectory, err = filepath.Abs(configDirectory)
+	if err != nil {
+		return err
+	}
+	for _, directory := range []*string{
+		&c.Output.Directory, &c.Backend.Directory, &c.Frontend.Directory, &c.TemplateDirectory,
+	} {
+		if *directory == "" || filepath.IsAbs(*directory) {
+			continue
+		}
+		resolved, err := filepath.Rel(wd, filepath.Join(configDirectory, *directory))
+		if err != nil {
+			return err
+		}
+		*directory = filepath.ToSlash(resolved)
+	}
+	return nil
+}
+
+// Validate returns every problem with the configuration at once
+func (c *PluginConfig) Validate() error {
+	var problems []string
+	for _, dir := range []struct {
+		key    string
+		config Config
+	}{{"output", c.Output}, {"backend", c.Backend}, {"frontend", c.Frontend}} {
+		if dir.config.Directory == "" {
+			problems = append(problems, fmt.Sprintf("sqlboiler.%v.directory is required", dir.key))
+		}
+		if dir.config.PackageName != "" && !token.IsIdentifier(dir.config.PackageName) {
+			problems = append(problems, fmt.Sprintf("sqlboiler.%v.package %q is not a valid go package name",
+				dir.key, dir.config.PackageName))
+		}
+	}
+	if c.Backend.Directory != "" {
+		if _, err := os.Stat(c.Backend.Directory); err != nil {
+			problems = append(problems, fmt.Sprintf("sqlboiler.backend.directory %q does not exist, "+
+				"it should contain the models generated by sqlboiler", c.Backend.Directory))
+		}
+	}
+	if c.TemplateDirectory != "" {
+		if info, err := os.Stat(c.TemplateDirectory); err != nil || !info.IsDir() {
+			problems = append(problems, fmt.Sprintf("sqlboiler.template_directory %q is not a directory",
+				c.TemplateDirectory))
+		}
+	}
+	if c.AuthImport != "" && strings.ContainsAny(c.AuthImport, " \\") {
+		problems = append(problems, fmt.Sprintf("sqlboiler.auth_import %q is not a valid import path", c.AuthImport))
+	}
+	for i, scope := range c.Scopes {
+		problems = append(problems, scope.Validate(fmt.Sprintf("sqlboiler.scopes[%d]", i), c.AuthImport)...)
+	}
+	if !c.DatabaseDriver.IsValid() {
+		problems = append(problems, fmt.Sprintf("sqlboiler.database_driver %q is not supported, use %v, %v or %v",
+			c.DatabaseDriver, Postgres, MySQL, SQLite))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%v", strings.Join(problems, "; "))
+}
+
+// NewConvertPluginFromConfig creates the convert plugin with the configuration of gqlgen-sqlboiler.yml
+func NewConvertPluginFromConfig(cfg *PluginConfig) plugin.Plugin {
+	return NewConvertPlugin(cfg.Output, cfg.Backend, cfg.Frontend, cfg.ConvertPluginConfig)
+}
+
+// NewResolverPluginFromConfig creates the resolver plugin with the configuration of gqlgen-sqlboiler.yml
+func NewResolverPluginFromConfig(cfg *PluginConfig) plugin.Plugin {
+	return NewResolverPluginWithConfig(cfg.Output, cfg.Backend, cfg.Frontend, cfg.AuthImport, ResolverPluginConfig{
+		TemplateDirectory: cfg.TemplateDirectory,
+		DryRun:            cfg.DryRun,
+		Scopes:            cfg.Scopes,
+	})
+}
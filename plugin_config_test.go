@@ -0,0 +1,131 @@
+package gqlgen_sqlboiler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadPluginConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gqlgen-sqlboiler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "models"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	wd, _ := os.Getwd()
+	defer os.Chdir(wd) //nolint:errcheck
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	writeConfig(t, `
+sqlboiler:
+  output:
+    directory: helpers
+  backend:
+    directory: models
+  frontend:
+    directory: graphql_models
+    package: graphql
+  auth_import: github.com/yourname/app/auth
+  database_driver: mysql
+  generate_order_by: true
+  dry_run: true
+`)
+	cfg, err := LoadPluginConfigFromDefaultLocations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Output.PackageName != "helpers" || cfg.Frontend.PackageName != "graphql" {
+		t.Errorf("package names should be helpers and graphql but are %v and %v",
+			cfg.Output.PackageName, cfg.Frontend.PackageName)
+	}
+	if cfg.DatabaseDriver != MySQL || !cfg.GenerateOrderBy || !cfg.DryRun {
+		t.Errorf("database_driver, generate_order_by and dry_run should be read")
+	}
+
+	writeConfig(t, `
+sqlboiler:
+  output:
+    directory: helpers
+  backend:
+    directory: sqlboiler_models
+  database_driver: oracle
+  generate_order: true
+`)
+	if _, err := LoadPluginConfigFromDefaultLocations(); err == nil || !strings.Contains(err.Error(), "generate_order") {
+		t.Errorf("unknown keys should result in an error but did result in %v", err)
+	}
+
+	writeConfig(t, `
+sqlboiler:
+  output:
+    directory: helpers
+  backend:
+    directory: sqlboiler_models
+  database_driver: oracle
+  scopes:
+    - column: tenant_id
+      operations: [read, insert]
+`)
+	_, err = LoadPluginConfigFromDefaultLocations()
+	for _, problem := range []string{
+		"sqlboiler.frontend.directory", "sqlboiler_models", "oracle", "sqlboiler.scopes[0].accessor", `"insert"`,
+	} {
+		if err == nil || !strings.Contains(err.Error(), problem) {
+			t.Errorf("error should contain %v but was %v", problem, err)
+		}
+	}
+}
+
+func writeConfig(t *testing.T, content string) {
+	if err := ioutil.WriteFile(PluginConfigFilenames[0], []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadPluginConfigFromSubdirectory(t *testing.T) {
+	dir := t.TempDir()
+	for _, subdirectory := range []string{"models", "templates", filepath.Join("cmd", "generate")} {
+		if err := os.MkdirAll(filepath.Join(dir, subdirectory), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wd, _ := os.Getwd()
+	defer os.Chdir(wd) //nolint:errcheck
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	writeConfig(t, `
+sqlboiler:
+  output:
+    directory: helpers
+  backend:
+    directory: ./models
+  frontend:
+    directory: graphql_models
+  template_directory: templates
+`)
+	if err := os.Chdir(filepath.Join("cmd", "generate")); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadPluginConfigFromDefaultLocations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Output.Directory != "../../helpers" || cfg.Backend.Directory != "../../models" ||
+		cfg.Frontend.Directory != "../../graphql_models" || cfg.TemplateDirectory != "../../templates" {
+		t.Errorf("directories should be relative to the configuration file but are %v, %v, %v and %v",
+			cfg.Output.Directory, cfg.Backend.Directory, cfg.Frontend.Directory, cfg.TemplateDirectory)
+	}
+	if cfg.Output.PackageName != "helpers" || cfg.Backend.PackageName != "models" {
+		t.Errorf("package names should be helpers and models but are %v and %v",
+			cfg.Output.PackageName, cfg.Backend.PackageName)
+	}
+}
@@ -2,9 +2,9 @@ package gqlgen_sqlboiler
 
 import (
 	"fmt"
-	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/iancoleman/strcase"
@@ -13,16 +13,23 @@ import (
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/codegen/templates"
 	"github.com/99designs/gqlgen/plugin"
-	"github.com/pkg/errors"
 )
 
 func NewResolverPlugin(output, backend, frontend Config, authImport string) plugin.Plugin {
+	return NewResolverPluginWithConfig(output, backend, frontend, authImport, ResolverPluginConfig{})
+}
+
+func NewResolverPluginWithConfig(
+	output, backend, frontend Config,
+	authImport string,
+	pluginConfig ResolverPluginConfig,
+) plugin.Plugin {
 	return &ResolverPlugin{
-		output:         output,
-		backend:        backend,
-		frontend:       frontend,
-		authImport:     authImport,
-		rootImportPath: getRootImportPath(),
+		output:       output,
+		backend:      backend,
+		frontend:     frontend,
+		authImport:   authImport,
+		pluginConfig: pluginConfig,
 	}
 }
 
@@ -31,9 +38,21 @@ type ResolverPlugin struct {
 	backend        Config
 	frontend       Config
 	authImport     string
+	pluginConfig   ResolverPluginConfig
 	rootImportPath string
 }
 
+type ResolverPluginConfig struct {
+	// TemplateDirectory holds your own resolver.gotpl or partials of it, the data it gets is ResolverBuild
+	TemplateDirectory string
+	// DryRun does not write the resolvers but prints how they differ from the files on disk, see ConvertPluginConfig.
+	// The files of gqlgen itself are still written.
+	DryRun bool
+	// Scopes limit the rows the resolvers can see and change, organization_id, user_organization_id and user_id are
+	// used when there is an authImport and no scopes are configured
+	Scopes []*Scope
+}
+
 var _ plugin.CodeGenerator = &ResolverPlugin{}
 
 func (m *ResolverPlugin) Name() string {
@@ -45,12 +64,28 @@ func (m *ResolverPlugin) GenerateCode(data *codegen.Data) error {
 		return nil
 	}
 
+	rootImportPath, err := getRootImportPath()
+	if err != nil {
+		return err
+	}
+	m.rootImportPath = rootImportPath
+
 	// Get all models information
 	fmt.Println("[resolver] get boiler models")
-	boilerModels := GetBoilerModels(m.backend.Directory)
+	boilerModels, err := GetBoilerModels(m.backend.Directory)
+	if err != nil {
+		return err
+	}
+	applyScopes(boilerModels, m.scopes())
 
 	fmt.Println("[resolver] get models with information")
-	models := GetModelsWithInformation(nil, data.Config, boilerModels)
+	models, err := GetModelsWithInformation(nil, data.Config, boilerModels)
+	if err != nil {
+		return err
+	}
+	for _, relation := range scopedRelationInputs(models) {
+		fmt.Println("[WARN]", relation+", the resolvers reject it")
+	}
 
 	fmt.Println("[resolver] generate file")
 	switch data.Config.Resolver.Layout {
@@ -65,28 +100,15 @@ func (m *ResolverPlugin) GenerateCode(data *codegen.Data) error {
 }
 
 func (m *ResolverPlugin) generateSingleFile(data *codegen.Data, models []*Model, _ []*BoilerModel) error {
-	file := File{}
+	file := File{imports: m.getImports()}
 
-	file.imports = append(file.imports, Import{
-		Alias:      ".",
-		ImportPath: path.Join(m.rootImportPath, m.output.Directory),
-	})
-
-	file.imports = append(file.imports, Import{
-		Alias:      "dm",
-		ImportPath: path.Join(m.rootImportPath, m.backend.Directory),
-	})
-	file.imports = append(file.imports, Import{
-		Alias:      "fm",
-		ImportPath: path.Join(m.rootImportPath, m.frontend.Directory),
-	})
-
-	hasAuth := m.authImport != ""
-	if hasAuth {
-		file.imports = append(file.imports, Import{
-			Alias:      "auth",
-			ImportPath: m.authImport,
-		})
+	rewriter, err := NewRewriter(data.Config.Resolver.ImportPath())
+	if err != nil {
+		return err
+	}
+	implementedMethods, err := rewriter.ImplementedMethods(data.Config.Resolver.Filename)
+	if err != nil {
+		return err
 	}
 
 	for _, o := range data.Objects {
@@ -94,7 +116,7 @@ func (m *ResolverPlugin) generateSingleFile(data *codegen.Data, models []*Model,
 			file.Objects = append(file.Objects, o)
 		}
 		for _, f := range o.Fields {
-			if !f.IsResolver {
+			if !f.IsResolver || isImplementedElsewhere(implementedMethods, o, f, data.Config.Resolver.Type) {
 				continue
 			}
 			resolver := &Resolver{
@@ -111,30 +133,149 @@ func (m *ResolverPlugin) generateSingleFile(data *codegen.Data, models []*Model,
 		}
 	}
 
+	template, err := loadTemplate(m.pluginConfig.TemplateDirectory, "resolver.gotpl")
+	if err != nil {
+		return &GenerateError{Template: "resolver.gotpl", Err: err}
+	}
+
+	setRelationAuthorizers(file.Resolvers)
 	resolverBuild := &ResolverBuild{
-		File:         &file,
-		PackageName:  data.Config.Resolver.Package,
-		ResolverType: data.Config.Resolver.Type,
-		HasRoot:      true,
-		HasAuth:      hasAuth,
-	}
-	templates.CurrentImports = nil
-	return templates.Render(templates.Options{
-		Template:    getTemplate("resolver.gotpl"),
-		PackageName: data.Config.Resolver.Package,
-		PackageDoc:  `// Generated with https://github.com/web-ridge/gqlgen-sqlboiler.`,
-		Filename:    data.Config.Resolver.Filename,
-		Data:        resolverBuild,
-		Packages:    data.Config.Packages,
+		File:              &file,
+		PackageName:       data.Config.Resolver.Package,
+		ResolverType:      data.Config.Resolver.Type,
+		HasRoot:           true,
+		HasAuth:           m.authImport != "",
+		CreateInputModels: getCreateInputModels(models),
+		Authorizers:       getAuthorizers(file.Resolvers),
+	}
+	return m.writeMerged(data.Config.Resolver.Filename, func(filename string) error {
+		return m.render(data, template, resolverBuild, filename,
+			`// Generated with https://github.com/web-ridge/gqlgen-sqlboiler.`)
+	})
+}
+
+func (m *ResolverPlugin) scopes() []*Scope {
+	if len(m.pluginConfig.Scopes) == 0 && m.authImport != "" {
+		return defaultScopes()
+	}
+	return m.pluginConfig.Scopes
+}
+
+// write calls write with the filename, in dry run mode with a temporary file which is compared with the filename
+func (m *ResolverPlugin) write(filename string, write func(filename string) error) error {
+	if m.pluginConfig.DryRun {
+		return dryRun(filename, write)
+	}
+	return write(filename)
+}
+
+// writeMerged is write for resolver files, your changes to the previous generated version are merged into the new
+// version after rendering
+func (m *ResolverPlugin) writeMerged(filename string, render func(filename string) error) error {
+	current, err := readFileIfExists(filename)
+	if err != nil {
+		return err
+	}
+	previous, err := readFileIfExists(mergeCacheFilename(filename))
+	if err != nil {
+		return err
+	}
+	return m.write(filename, func(generatedFilename string) error {
+		if err := render(generatedFilename); err != nil {
+			return err
+		}
+		var errs GenerateErrors
+		errs.Append("resolver.gotpl", mergeWithPrevious(generatedFilename, current, previous))
+		return errs.ErrorOrNil()
 	})
 }
 
-func (m *ResolverPlugin) generatePerSchema(data *codegen.Data, models []*Model, boilerModels []*BoilerModel) error {
+// getImports returns the packages the generated resolvers use
+func (m *ResolverPlugin) getImports() []Import {
+	imports := []Import{
+		{Alias: ".", ImportPath: path.Join(m.rootImportPath, m.output.Directory)},
+		{Alias: "dm", ImportPath: path.Join(m.rootImportPath, m.backend.Directory)},
+		{Alias: "fm", ImportPath: path.Join(m.rootImportPath, m.frontend.Directory)},
+	}
+	if m.authImport != "" {
+		imports = append(imports, Import{Alias: "auth", ImportPath: m.authImport})
+	}
+	return imports
+}
+
+// render writes resolver.gotpl to the file, if the template fails we find out which resolvers are the cause
+func (m *ResolverPlugin) render(data *codegen.Data, template string, resolverBuild *ResolverBuild, filename,
+	packageDoc string) error {
+	render := func(resolverBuild *ResolverBuild, filename string) error {
+		templates.CurrentImports = nil
+		return templates.Render(templates.Options{
+			Template:    template,
+			PackageName: data.Config.Resolver.Package,
+			PackageDoc:  packageDoc,
+			Filename:    filename,
+			Data:        resolverBuild,
+			Packages:    data.Config.Packages,
+		})
+	}
+	err := render(resolverBuild, filename)
+	if !isTemplateExecError(err) {
+		var errs GenerateErrors
+		errs.Append("resolver.gotpl", err)
+		return errs.ErrorOrNil()
+	}
+	return findFailingResolvers(err, resolverBuild.Resolvers, func(resolvers []*Resolver) error {
+		attemptFile := *resolverBuild.File
+		attemptFile.Resolvers = resolvers
+		attempt := *resolverBuild
+		attempt.File = &attemptFile
+		return renderInTempDir(func(dir string) error {
+			return render(&attempt, filepath.Join(dir, filepath.Base(filename)))
+		})
+	})
+}
+
+// findFailingResolvers renders the resolvers one by one to find out which ones break the template
+func findFailingResolvers(err error, resolvers []*Resolver, render func(resolvers []*Resolver) error) error {
+	var errs GenerateErrors
+	for _, resolver := range resolvers {
+		if resolverErr := render([]*Resolver{resolver}); isTemplateExecError(resolverErr) {
+			errs = append(errs, &GenerateError{
+				Template: "resolver.gotpl",
+				Model:    resolver.Object.Name,
+				Field:    resolver.Field.Name,
+				Err:      resolverErr,
+			})
+		}
+	}
+	if len(errs) == 0 {
+		errs.Append("resolver.gotpl", err)
+	}
+	return errs
+}
+
+// generatePerSchema writes the resolvers of every schema file to {schema}.resolvers.go and the root resolver to the
+// resolver filename. Resolvers we can not generate keep the implementation they already had.
+func (m *ResolverPlugin) generatePerSchema(data *codegen.Data, models []*Model, _ []*BoilerModel) error {
 	rewriter, err := NewRewriter(data.Config.Resolver.ImportPath())
 	if err != nil {
 		return err
 	}
 
+	var generatedFilenames []string
+	for _, o := range data.Objects {
+		for _, f := range o.Fields {
+			if f.IsResolver {
+				generatedFilenames = append(generatedFilenames,
+					gqlToResolverName(data.Config.Resolver.Dir(), f.Position.Src.Name))
+			}
+		}
+	}
+	implementedMethods, err := rewriter.ImplementedMethods(append(generatedFilenames,
+		data.Config.Resolver.Filename)...)
+	if err != nil {
+		return err
+	}
+
 	files := map[string]*File{}
 
 	for _, o := range data.Objects {
@@ -149,13 +290,12 @@ func (m *ResolverPlugin) generatePerSchema(data *codegen.Data, models []*Model,
 			files[fn].Objects = append(files[fn].Objects, o)
 		}
 		for _, f := range o.Fields {
-			if !f.IsResolver {
+			if !f.IsResolver || isImplementedElsewhere(implementedMethods, o, f, data.Config.Resolver.Type) {
 				continue
 			}
 
 			structName := templates.LcFirst(o.Name) + templates.UcFirst(data.Config.Resolver.Type)
 			implementation := strings.TrimSpace(rewriter.GetMethodBody(structName, f.GoFieldName))
-			// enhanceResolverWithBools(f)
 			if implementation == "" {
 				implementation = `panic(fmt.Errorf("not implemented"))`
 			}
@@ -165,6 +305,16 @@ func (m *ResolverPlugin) generatePerSchema(data *codegen.Data, models []*Model,
 				Field:          f,
 				Implementation: implementation,
 			}
+			enhanceResolver(resolver, models)
+			if resolver.IsGenerated() {
+				rewriter.MarkConstCopied(resolver.PublicErrorKey)
+			} else {
+				resolver = &Resolver{
+					Object:         o,
+					Field:          f,
+					Implementation: implementation,
+				}
+			}
 			fn := gqlToResolverName(data.Config.Resolver.Dir(), f.Position.Src.Name)
 			if files[fn] == nil {
 				files[fn] = &File{}
@@ -175,56 +325,102 @@ func (m *ResolverPlugin) generatePerSchema(data *codegen.Data, models []*Model,
 	}
 
 	for filename, file := range files {
-		file.imports = rewriter.ExistingImports(filename)
+		file.imports = mergeImports(rewriter.ExistingImports(filename), m.getImports())
 		file.RemainingSource = rewriter.RemainingSource(filename)
 	}
 
-	for filename, file := range files {
+	template, err := loadTemplate(m.pluginConfig.TemplateDirectory, "resolver.gotpl")
+	if err != nil {
+		return &GenerateError{Template: "resolver.gotpl", Err: err}
+	}
+
+	// sorted so the errors are in the same order every time
+	filenames := make([]string, 0, len(files))
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	// the root resolver holds the database and the helpers every schema file uses
+	var resolvers []*Resolver
+	for _, filename := range filenames {
+		resolvers = append(resolvers, files[filename].Resolvers...)
+	}
+	setRelationAuthorizers(resolvers)
+
+	var errs GenerateErrors
+	for _, filename := range filenames {
 		resolverBuild := &ResolverBuild{
-			File:         file,
+			File:         files[filename],
 			PackageName:  data.Config.Resolver.Package,
 			ResolverType: data.Config.Resolver.Type,
+			HasAuth:      m.authImport != "",
 		}
+		errs.Append("resolver.gotpl", m.writeMerged(filename, func(filename string) error {
+			return m.render(data, template, resolverBuild, filename, `
+			// This file will be automatically regenerated based on the schema, any resolver implementations
+			// will be copied through when generating and any unknown code will be moved to the end.`)
+		}))
+	}
 
-		err := templates.Render(templates.Options{
-			PackageName: data.Config.Resolver.Package,
-			PackageDoc: `
-				// This file will be automatically regenerated based on the schema, any resolver implementations
-				// will be copied through when generating and any unknown code will be moved to the end.`,
-			Filename: filename,
-			Data:     resolverBuild,
-			Packages: data.Config.Packages,
-		})
-		if err != nil {
-			return err
-		}
+	rootBuild := &ResolverBuild{
+		File:              &File{imports: m.getImports()},
+		PackageName:       data.Config.Resolver.Package,
+		ResolverType:      data.Config.Resolver.Type,
+		HasRoot:           true,
+		HasAuth:           m.authImport != "",
+		CreateInputModels: getCreateInputModels(models),
+		Authorizers:       getAuthorizers(resolvers),
 	}
+	errs.Append("resolver.gotpl", m.writeMerged(data.Config.Resolver.Filename, func(filename string) error {
+		return m.render(data, template, rootBuild, filename,
+			`// Generated with https://github.com/web-ridge/gqlgen-sqlboiler.`)
+	}))
+	return errs.ErrorOrNil()
+}
 
-	if _, err := os.Stat(data.Config.Resolver.Filename); os.IsNotExist(errors.Cause(err)) {
-		err := templates.Render(templates.Options{
-			PackageName: data.Config.Resolver.Package,
-			PackageDoc: `
-				// This file will not be regenerated automatically.
-				//
-				// It serves as dependency injection for your app, add any dependencies you require here.`,
-			Template: `type {{.}} struct {}`,
-			Filename: data.Config.Resolver.Filename,
-			Data:     data.Config.Resolver.Type,
-			Packages: data.Config.Packages,
-		})
-		if err != nil {
-			return err
+// mergeImports keeps the imports of the existing file so implementations which are copied through still compile, when
+// both import the same package or use the same alias the generated import is used
+func mergeImports(existing, generated []Import) []Import {
+	imports := append([]Import{}, generated...)
+	for _, existingImport := range existing {
+		isGenerated := false
+		for _, generatedImport := range generated {
+			if existingImport.ImportPath == generatedImport.ImportPath ||
+				(existingImport.Alias != "" && existingImport.Alias == generatedImport.Alias) {
+				isGenerated = true
+				break
+			}
+		}
+		if !isGenerated {
+			imports = append(imports, existingImport)
 		}
 	}
-	return nil
+	return imports
 }
 
+// ResolverBuild is the data of resolver.gotpl. Custom templates depend on it so fields are only added, not renamed or
+// removed.
 type ResolverBuild struct {
 	*File
 	HasAuth      bool
 	HasRoot      bool
 	PackageName  string
 	ResolverType string
+	// CreateInputModels get an insert function which also inserts their nested relations
+	CreateInputModels []*Model
+	// Authorizers are generated with the root resolver for every model which has generated resolvers
+	Authorizers []*Authorizer
+}
+
+func getCreateInputModels(models []*Model) []*Model {
+	var createInputModels []*Model
+	for _, m := range models {
+		if m.IsCreateInput && m.BoilerModel != nil && m.BoilerModel.Name != "" {
+			createInputModels = append(createInputModels, m)
+		}
+	}
+	return createInputModels
 }
 
 type File struct {
@@ -253,12 +449,17 @@ type Resolver struct {
 	Implementation            string
 	IsSingle                  bool
 	IsList                    bool
+	IsConnection              bool
+	HasOrderBy                bool
 	IsCreate                  bool
 	IsUpdate                  bool
 	IsDelete                  bool
 	IsBatchCreate             bool
 	IsBatchUpdate             bool
 	IsBatchDelete             bool
+	IsAddRelation             bool
+	IsRemoveRelation          bool
+	IsSetRelation             bool
 	BoilerWhiteList           string
 	ResolveOrganizationID     bool
 	ResolveUserOrganizationID bool
@@ -266,8 +467,81 @@ type Resolver struct {
 	Model                     Model
 	InputModel                Model
 
+	// RelationField is the many-to-many relationship which is changed by add, remove and set mutations e.g.
+	// addPostTags(postId: ID!, tagIds: [ID!]!)
+	RelationField       *BoilerField
+	RelationModel       Model
+	IDArgument          string
+	RelationIDsArgument string
+
+	// HardDeleteArgument is e.g. hardDelete != nil && *hardDelete when a delete mutation of a model with soft deletes
+	// has a hardDelete argument, the row is removed instead of setting deleted_at then
+	HardDeleteArgument string
+	// WithDeletedArgument is e.g. withDeleted != nil && *withDeleted when a query of a model with soft deletes has a
+	// withDeleted argument, the soft deleted rows are included then
+	WithDeletedArgument string
+
 	PublicErrorKey     string
 	PublicErrorMessage string
+
+	// Authorizer of the model which is asked before resolving
+	Authorizer *Authorizer
+	// RelationAuthorizer of the related model of an add, remove or set relation mutation, its read mods limit the rows
+	// which can be related. It is nil when the related model has no generated resolvers.
+	RelationAuthorizer *Authorizer
+}
+
+// IsGenerated is false when we don't know how to resolve the field, the template writes the Implementation for those
+func (r *Resolver) IsGenerated() bool {
+	if r.Model.BoilerModel == nil || r.Model.BoilerModel.Name == "" {
+		return false
+	}
+	return r.IsSingle || r.IsList || r.IsConnection || r.IsCreate || r.IsUpdate || r.IsDelete ||
+		r.IsBatchCreate || r.IsBatchUpdate || r.IsBatchDelete ||
+		r.IsAddRelation || r.IsRemoveRelation || r.IsSetRelation
+}
+
+// Operation is what the resolver does e.g. create or batchDelete, the logger gets it with every error
+func (r *Resolver) Operation() string {
+	switch {
+	case r.IsSingle:
+		return "single"
+	case r.IsList:
+		return "list"
+	case r.IsConnection:
+		return "connection"
+	case r.IsCreate:
+		return "create"
+	case r.IsUpdate:
+		return "update"
+	case r.IsDelete:
+		return "delete"
+	case r.IsBatchCreate:
+		return "batchCreate"
+	case r.IsBatchUpdate:
+		return "batchUpdate"
+	case r.IsBatchDelete:
+		return "batchDelete"
+	case r.IsAddRelation:
+		return "add" + r.RelationField.Name
+	case r.IsRemoveRelation:
+		return "remove" + r.RelationField.Name
+	case r.IsSetRelation:
+		return "set" + r.RelationField.Name
+	}
+	return ""
+}
+
+// isImplementedElsewhere is true when the resolver is already implemented in one of your own files in the resolver
+// package e.g. resolver_custom.go, we don't generate those so your implementation stays as it is
+func isImplementedElsewhere(implementedMethods map[string]string, o *codegen.Object, f *codegen.Field,
+	resolverType string) bool {
+	structName := templates.LcFirst(o.Name) + templates.UcFirst(resolverType)
+	filename, ok := implementedMethods[structName+"."+f.GoFieldName]
+	if ok {
+		fmt.Println("[resolver] skipping", o.Name+"."+f.GoFieldName, "since it is implemented in", filepath.Base(filename))
+	}
+	return ok
 }
 
 func gqlToResolverName(base string, gqlname string) string {
@@ -293,17 +567,27 @@ func enhanceResolver(r *Resolver, models []*Model) {
 	switch r.Object.Name {
 	case "Mutation":
 		{
+			if enhanceRelationResolver(r, models) {
+				break
+			}
 			r.IsCreate = containsPrefixAndPartAfterThatIsSingle(nameOfResolver, "Create")
 			r.IsUpdate = containsPrefixAndPartAfterThatIsSingle(nameOfResolver, "Update")
 			r.IsDelete = containsPrefixAndPartAfterThatIsSingle(nameOfResolver, "Delete")
 			r.IsBatchCreate = containsPrefixAndPartAfterThatIsPlural(nameOfResolver, "Create")
 			r.IsBatchUpdate = containsPrefixAndPartAfterThatIsPlural(nameOfResolver, "Update")
 			r.IsBatchDelete = containsPrefixAndPartAfterThatIsPlural(nameOfResolver, "Delete")
+			if r.IsDelete || r.IsBatchDelete {
+				r.HardDeleteArgument = softDeleteArgument(r, "hardDelete")
+			}
 		}
 	case "Query":
 		{
-			r.IsList = pluralizer.IsPlural(nameOfResolver)
-			r.IsSingle = !r.IsList
+			isPlural := pluralizer.IsPlural(nameOfResolver)
+			r.IsConnection = isPlural && isConnectionType(r.Field)
+			r.IsList = isPlural && !r.IsConnection
+			r.IsSingle = !isPlural
+			r.HasOrderBy = (r.IsList || r.IsConnection) && hasArgument(r.Field, "orderBy")
+			r.WithDeletedArgument = softDeleteArgument(r, "withDeleted")
 		}
 	default:
 		{
@@ -311,20 +595,23 @@ func enhanceResolver(r *Resolver, models []*Model) {
 		}
 	}
 
-	lmName := strcase.ToLowerCamel(model.Name)
-	lmpName := strcase.ToLowerCamel(model.PluralName)
+	lmName := strcase.ToLowerCamel(r.Model.Name)
+	lmpName := strcase.ToLowerCamel(r.Model.PluralName)
 	r.PublicErrorKey = "public"
 
 	if (r.IsCreate || r.IsDelete || r.IsUpdate) && strings.HasSuffix(lmName, "Batch") {
 		r.PublicErrorKey += "One"
 	}
-	r.PublicErrorKey += model.Name
+	r.PublicErrorKey += r.Model.Name
 	if r.IsSingle {
 		r.PublicErrorKey += "Single"
 		r.PublicErrorMessage = "could not get " + lmName
 	} else if r.IsList {
 		r.PublicErrorKey += "List"
 		r.PublicErrorMessage = "could not list " + lmpName
+	} else if r.IsConnection {
+		r.PublicErrorKey += "Connection"
+		r.PublicErrorMessage = "could not list " + lmpName
 	} else if r.IsCreate {
 		r.PublicErrorKey += "Create"
 		r.PublicErrorMessage = "could not create " + lmName
@@ -343,8 +630,110 @@ func enhanceResolver(r *Resolver, models []*Model) {
 	} else if r.IsBatchDelete {
 		r.PublicErrorKey += "BatchDelete"
 		r.PublicErrorMessage = "could not delete " + lmpName
+	} else if r.IsAddRelation {
+		r.PublicErrorKey += "Add" + r.RelationField.Name
+		r.PublicErrorMessage = "could not add " + strcase.ToDelimited(r.RelationField.Name, ' ') + " to " + lmName
+	} else if r.IsRemoveRelation {
+		r.PublicErrorKey += "Remove" + r.RelationField.Name
+		r.PublicErrorMessage = "could not remove " + strcase.ToDelimited(r.RelationField.Name, ' ') + " from " + lmName
+	} else if r.IsSetRelation {
+		r.PublicErrorKey += "Set" + r.RelationField.Name
+		r.PublicErrorMessage = "could not set " + strcase.ToDelimited(r.RelationField.Name, ' ') + " of " + lmName
 	}
 	r.PublicErrorKey += "Error"
+	r.Authorizer = getAuthorizer(models, r.Model.Name)
+}
+
+var RelationMutationTypes = []string{"Add", "Remove", "Set"} //nolint:gochecknoglobals
+
+// enhanceRelationResolver recognizes mutations which change a many-to-many relationship e.g. addPostTags,
+// removePostTags and setPostTags. These are generated with the AddTags, RemoveTags and SetTags methods of sqlboiler.
+func enhanceRelationResolver(r *Resolver, models []*Model) bool {
+	nameOfResolver := r.Field.GoFieldName
+	for _, mutationType := range RelationMutationTypes {
+		if !strings.HasPrefix(nameOfResolver, mutationType) {
+			continue
+		}
+		model, relationField := findManyToManyRelation(models, strings.TrimPrefix(nameOfResolver, mutationType))
+		if model == nil {
+			continue
+		}
+		if len(r.Field.Args) != 2 {
+			fmt.Printf("[WARN] %v should have 2 arguments e.g. %v(%vId: ID!, %vIds: [ID!]!)\n",
+				r.Field.Name, r.Field.Name, strcase.ToLowerCamel(model.Name),
+				strcase.ToLowerCamel(pluralizer.Singular(relationField.Name)))
+			return false
+		}
+
+		r.Model = *model
+		r.RelationField = relationField
+		r.RelationModel = findModelOrEmpty(models, relationField.Relationship.Name)
+		r.IDArgument = r.Field.Args[0].VarName
+		r.RelationIDsArgument = r.Field.Args[1].VarName
+		r.IsAddRelation = mutationType == "Add"
+		r.IsRemoveRelation = mutationType == "Remove"
+		r.IsSetRelation = mutationType == "Set"
+		return true
+	}
+	return false
+}
+
+// findManyToManyRelation finds e.g. Post and Tags for PostTags
+func findManyToManyRelation(models []*Model, v string) (*Model, *BoilerField) {
+	var foundModel *Model
+	var foundField *BoilerField
+	for _, m := range models {
+		if !m.IsNormal || m.BoilerModel == nil || !strings.HasPrefix(v, m.Name) {
+			continue
+		}
+		// prefer the longest model name e.g. UserRole over User
+		if foundModel != nil && len(foundModel.Name) > len(m.Name) {
+			continue
+		}
+		field := findBoilerField(m.BoilerModel.Fields, strings.TrimPrefix(v, m.Name))
+		if field != nil && field.IsManyToMany {
+			foundModel = m
+			foundField = field
+		}
+	}
+	return foundModel, foundField
+}
+
+// isConnectionType returns true if the field returns a Relay connection e.g. PostConnection
+func isConnectionType(field *codegen.Field) bool {
+	if field.TypeReference == nil || field.TypeReference.Definition == nil {
+		return false
+	}
+	return strings.HasSuffix(field.TypeReference.Definition.Name, "Connection")
+}
+
+func hasArgument(field *codegen.Field, name string) bool {
+	for _, arg := range field.Args {
+		if arg.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// softDeleteArgument returns the value of a Boolean argument like hardDelete as Go expression, it is empty when the
+// field has no such argument or the model can not soft delete
+func softDeleteArgument(r *Resolver, name string) string {
+	for _, arg := range r.Field.Args {
+		if arg.Name != name || arg.TypeReference == nil || arg.TypeReference.Definition.Name != "Boolean" {
+			continue
+		}
+		if r.Model.BoilerModel == nil || !r.Model.BoilerModel.CanSoftDelete {
+			fmt.Println("[WARN]", name, "argument of", r.Object.Name+"."+r.Field.Name, "is ignored since",
+				r.Model.Name, "can not soft delete")
+			return ""
+		}
+		if arg.TypeReference.IsPtr() {
+			return arg.VarName + " != nil && *" + arg.VarName
+		}
+		return arg.VarName
+	}
+	return ""
 }
 
 func findModelOrEmpty(models []*Model, modelName string) Model {
@@ -0,0 +1,145 @@
+package gqlgen_sqlboiler
+
+import (
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestMergeImports(t *testing.T) {
+	existing := []Import{
+		{ImportPath: "context"},
+		{Alias: "dm", ImportPath: "github.com/yourname/app/old_models"},
+		{Alias: "fm", ImportPath: "github.com/yourname/app/graphql_models"},
+		{ImportPath: "strings"},
+	}
+	generated := []Import{
+		{Alias: "dm", ImportPath: "github.com/yourname/app/models"},
+		{Alias: "fm", ImportPath: "github.com/yourname/app/graphql_models"},
+	}
+	expected := []Import{
+		{Alias: "dm", ImportPath: "github.com/yourname/app/models"},
+		{Alias: "fm", ImportPath: "github.com/yourname/app/graphql_models"},
+		{ImportPath: "context"},
+		{ImportPath: "strings"},
+	}
+	if merged := mergeImports(existing, generated); !reflect.DeepEqual(merged, expected) {
+		t.Errorf("imports should be %v but are %v", expected, merged)
+	}
+}
+
+func TestResolverOperation(t *testing.T) {
+	tests := []struct {
+		resolver *Resolver
+		expected string
+	}{
+		{&Resolver{IsConnection: true}, "connection"},
+		{&Resolver{IsBatchDelete: true}, "batchDelete"},
+		{&Resolver{IsAddRelation: true, RelationField: &BoilerField{Name: "Tags"}}, "addTags"},
+		{&Resolver{}, ""},
+	}
+	for _, test := range tests {
+		if operation := test.resolver.Operation(); operation != test.expected {
+			t.Errorf("operation should be %q but is %q", test.expected, operation)
+		}
+	}
+}
+
+func TestSoftDeleteArgument(t *testing.T) {
+	booleanArgument := func(name string, goType types.Type) *codegen.FieldArgument {
+		return &codegen.FieldArgument{
+			ArgumentDefinition: &ast.ArgumentDefinition{Name: name},
+			TypeReference:      &config.TypeReference{Definition: &ast.Definition{Name: "Boolean"}, GO: goType},
+			VarName:            name,
+		}
+	}
+	field := &codegen.Field{
+		FieldDefinition: &ast.FieldDefinition{Name: "posts"},
+		Args: []*codegen.FieldArgument{
+			booleanArgument("withDeleted", types.NewPointer(types.Typ[types.Bool])),
+			booleanArgument("hardDelete", types.Typ[types.Bool]),
+		},
+	}
+	softDelete := Model{Name: "Post", BoilerModel: &BoilerModel{CanSoftDelete: true}}
+	hardDelete := Model{Name: "Post", BoilerModel: &BoilerModel{}}
+
+	tests := []struct {
+		model    Model
+		name     string
+		expected string
+	}{
+		{softDelete, "withDeleted", "withDeleted != nil && *withDeleted"},
+		{softDelete, "hardDelete", "hardDelete"},
+		{softDelete, "filter", ""},
+		{hardDelete, "withDeleted", ""},
+	}
+	for _, test := range tests {
+		r := &Resolver{Object: &codegen.Object{Definition: &ast.Definition{Name: "Query"}}, Field: field, Model: test.model}
+		if argument := softDeleteArgument(r, test.name); argument != test.expected {
+			t.Errorf("%v should be %q but is %q", test.name, test.expected, argument)
+		}
+	}
+}
+
+func TestWriteMergedKeepsEditedResolver(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "schema.resolvers.go")
+	m := &ResolverPlugin{}
+	generate := func(source string) {
+		t.Helper()
+		err := m.writeMerged(filename, func(filename string) error {
+			return ioutil.WriteFile(filename, []byte(source), 0600)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	generate(mergeBase)
+	edited := strings.Replace(mergeBase, `return "b"`, `return "your b"`, 1)
+	if err := ioutil.WriteFile(filename, []byte(edited), 0600); err != nil {
+		t.Fatal(err)
+	}
+	generate(strings.Replace(mergeBase, `return "c"`, `return "generated c"`, 1))
+
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{`return "your b"`, `return "generated c"`} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("regenerated resolvers should contain %v but are\n%s", expected, content)
+		}
+	}
+}
+
+func TestSoftDeletePartials(t *testing.T) {
+	softDelete := Model{Name: "Post", BoilerModel: &BoilerModel{CanSoftDelete: true}}
+	hardDelete := Model{Name: "Comment", BoilerModel: &BoilerModel{}}
+
+	tests := []struct {
+		partial  string
+		resolver *Resolver
+		expected string
+	}{
+		{"withDeleted", &Resolver{Model: softDelete, WithDeletedArgument: "withDeleted != nil && *withDeleted"},
+			"if withDeleted != nil && *withDeleted { mods = append(mods, qm.WithDeleted()) }"},
+		{"withDeleted", &Resolver{Model: softDelete, HardDeleteArgument: "hardDelete"},
+			"if hardDelete { // rows which are already soft deleted can be removed too mods = append(mods, qm.WithDeleted()) }"},
+		{"withDeleted", &Resolver{Model: softDelete}, ""},
+		{"hardDelete", &Resolver{Model: softDelete, HardDeleteArgument: "hardDelete"}, ", hardDelete"},
+		{"hardDelete", &Resolver{Model: softDelete}, ", false"},
+		{"hardDelete", &Resolver{Model: hardDelete}, ""},
+	}
+	for _, test := range tests {
+		if output := renderPartial(t, "resolver.gotpl", test.partial, test.resolver); output != test.expected {
+			t.Errorf("%v should render %q but renders %q", test.partial, test.expected, output)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package gqlgen_sqlboiler
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+)
+
+// ScopeOperation is what a resolver does with the rows of a scoped model
+type ScopeOperation string
+
+const (
+	ScopeRead   ScopeOperation = "read"
+	ScopeCreate ScopeOperation = "create"
+	ScopeUpdate ScopeOperation = "update"
+	ScopeDelete ScopeOperation = "delete"
+)
+
+func (o ScopeOperation) IsValid() bool {
+	switch o {
+	case ScopeRead, ScopeCreate, ScopeUpdate, ScopeDelete:
+		return true
+	}
+	return false
+}
+
+// Scope limits the rows of every model with the column to the value in the context e.g. tenant_id to
+// auth.TenantIDFromContext(ctx). Reading, updating and deleting only see rows with the value and creating fills it in.
+//
+//	scopes:
+//	  - column: tenant_id
+//	    accessor: auth.TenantIDFromContext
+//	  - column: workspace_id
+//	    accessor: auth.WorkspaceIDFromContext
+//	    operations: [read, create]
+type Scope struct {
+	// Column in the database e.g. tenant_id
+	Column string `yaml:"column"`
+	// Accessor is called with the context and returns the value of the column, auth refers to the auth_import
+	Accessor string `yaml:"accessor"`
+	// Operations the scope is used for, every operation if empty
+	Operations []ScopeOperation `yaml:"operations"`
+}
+
+// defaultScopes are used when there is an auth import and no scopes are configured
+func defaultScopes() []*Scope {
+	return []*Scope{
+		{Column: "organization_id", Accessor: "auth.OrganizationIDFromContext"},
+		{Column: "user_organization_id", Accessor: "auth.OrganizationIDFromContext"},
+		{Column: "user_id", Accessor: "auth.UserIDFromContext"},
+	}
+}
+
+func (s *Scope) HasOperation(operation ScopeOperation) bool {
+	if len(s.Operations) == 0 {
+		return true
+	}
+	for _, o := range s.Operations {
+		if o == operation {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns every problem with the scope, key is the location in the configuration e.g. sqlboiler.scopes[0].
+// The accessor is a function of the resolver package or of the auth package so that needs an auth import.
+func (s *Scope) Validate(key string, authImport string) []string {
+	var problems []string
+	if s.Column == "" {
+		problems = append(problems, key+".column is required")
+	}
+	packageName, function := "", s.Accessor
+	if i := strings.Index(s.Accessor, "."); i >= 0 {
+		packageName, function = s.Accessor[:i], s.Accessor[i+1:]
+	}
+	switch {
+	case s.Accessor == "":
+		problems = append(problems, key+".accessor is required")
+	case !token.IsIdentifier(function) || (packageName != "" && !token.IsIdentifier(packageName)):
+		problems = append(problems, fmt.Sprintf("%v.accessor %q is not a function e.g. auth.TenantIDFromContext",
+			key, s.Accessor))
+	case packageName != "" && packageName != "auth":
+		problems = append(problems, fmt.Sprintf("%v.accessor %q can only call a function of the auth package or "+
+			"the resolver package", key, s.Accessor))
+	case packageName == "auth" && authImport == "":
+		problems = append(problems, fmt.Sprintf("%v.accessor %q uses the auth package but sqlboiler.auth_import "+
+			"is not set", key, s.Accessor))
+	}
+	for _, o := range s.Operations {
+		if !o.IsValid() {
+			problems = append(problems, fmt.Sprintf("%v.operations %q is not supported, use %v", key, o,
+				strings.Join([]string{string(ScopeRead), string(ScopeCreate), string(ScopeUpdate), string(ScopeDelete)},
+					", ")))
+		}
+	}
+	return problems
+}
+
+// BoilerScope is a scope which applies to the model since it has the column
+type BoilerScope struct {
+	*Scope
+	Model *BoilerModel
+	// Field of the column e.g. TenantID
+	Field *BoilerField
+}
+
+// ScopesFor returns the scopes which limit the operation e.g. {{ range .ScopesFor "read" }}
+func (m *BoilerModel) ScopesFor(operation ScopeOperation) []*BoilerScope {
+	var scopes []*BoilerScope
+	for _, scope := range m.Scopes {
+		if scope.HasOperation(operation) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// ScopeOf returns the scope which sets the field for the operation e.g. the user_id of a comment when it is created, it
+// is nil when the field is not scoped
+func (m *BoilerModel) ScopeOf(fieldName string, operation ScopeOperation) *BoilerScope {
+	for _, scope := range m.ScopesFor(operation) {
+		if scope.Field.Name == fieldName {
+			return scope
+		}
+	}
+	return nil
+}
+
+// scopedRelationInputs returns the relations of create inputs which can not be created since the scope sets their
+// foreign key e.g. the user of CommentCreateInput when user_id is scoped, their resolvers reject them
+func scopedRelationInputs(models []*Model) []string {
+	var relations []string
+	for _, m := range models {
+		if !m.IsCreateInput || m.BoilerModel == nil {
+			continue
+		}
+		for _, field := range m.Fields {
+			if field.RelationshipInput == nil || !field.BoilerField.IsForeignKey {
+				continue
+			}
+			if scope := m.BoilerModel.ScopeOf(field.BoilerField.Name, ScopeCreate); scope != nil {
+				relations = append(relations, fmt.Sprintf("%v.%v can not be created since %v is set by %v", m.Name,
+					field.JSONName, scope.Column, scope.Accessor))
+			}
+		}
+	}
+	return relations
+}
+
+// applyScopes adds the scopes to every model which has the column
+func applyScopes(boilerModels []*BoilerModel, scopes []*Scope) {
+	for _, m := range boilerModels {
+		m.Scopes = nil
+		for _, scope := range scopes {
+			if field := findBoilerFieldByColumn(m.Fields, scope.Column); field != nil {
+				m.Scopes = append(m.Scopes, &BoilerScope{Scope: scope, Model: m, Field: field})
+			}
+		}
+	}
+}
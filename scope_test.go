@@ -0,0 +1,94 @@
+package gqlgen_sqlboiler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplyScopes(t *testing.T) {
+	post := &BoilerModel{Name: "Post", Fields: []*BoilerField{
+		{Name: "ID", ColumnName: "id"},
+		{Name: "TenantID", ColumnName: "tenant_id"},
+		{Name: "WorkspaceID", ColumnName: "workspace_id"},
+	}}
+	tag := &BoilerModel{Name: "Tag", Fields: []*BoilerField{{Name: "ID", ColumnName: "id"}}}
+	applyScopes([]*BoilerModel{post, tag}, []*Scope{
+		{Column: "tenant_id", Accessor: "auth.TenantIDFromContext"},
+		{Column: "workspace_id", Accessor: "auth.WorkspaceIDFromContext", Operations: []ScopeOperation{ScopeRead}},
+	})
+
+	if len(tag.Scopes) != 0 {
+		t.Errorf("Tag has no scoped columns but got %v scopes", len(tag.Scopes))
+	}
+	tests := []struct {
+		operation ScopeOperation
+		fields    []string
+	}{
+		{ScopeRead, []string{"TenantID", "WorkspaceID"}},
+		{ScopeCreate, []string{"TenantID"}},
+		{ScopeDelete, []string{"TenantID"}},
+	}
+	for _, test := range tests {
+		scopes := post.ScopesFor(test.operation)
+		if len(scopes) != len(test.fields) {
+			t.Errorf("%v should have %v scopes but has %v", test.operation, len(test.fields), len(scopes))
+			continue
+		}
+		for i, scope := range scopes {
+			if scope.Field.Name != test.fields[i] || scope.Model != post {
+				t.Errorf("%v scope %v should be Post.%v but is %v.%v", test.operation, i, test.fields[i],
+					scope.Model.Name, scope.Field.Name)
+			}
+		}
+	}
+}
+
+func TestScopeValidate(t *testing.T) {
+	tests := []struct {
+		scope      Scope
+		authImport string
+		problem    string
+	}{
+		{Scope{Column: "tenant_id", Accessor: "auth.TenantIDFromContext"}, "github.com/yourname/app/auth", ""},
+		{Scope{Column: "tenant_id", Accessor: "TenantIDFromContext"}, "", ""},
+		{Scope{Column: "tenant_id", Accessor: "auth.UserID"}, "", "uses the auth package but sqlboiler.auth_import"},
+		{Scope{Column: "tenant_id", Accessor: "session.TenantID"}, "github.com/yourname/app/auth",
+			"can only call a function of the auth package"},
+		{Scope{Column: "tenant_id", Accessor: "auth.TenantID(ctx)"}, "github.com/yourname/app/auth",
+			"is not a function"},
+		{Scope{Accessor: "auth.TenantIDFromContext"}, "github.com/yourname/app/auth", "scopes[0].column is required"},
+		{Scope{Column: "tenant_id"}, "github.com/yourname/app/auth", "scopes[0].accessor is required"},
+	}
+	for _, test := range tests {
+		problems := test.scope.Validate("sqlboiler.scopes[0]", test.authImport)
+		if test.problem == "" {
+			if len(problems) != 0 {
+				t.Errorf("%+v should be valid but has problems %v", test.scope, problems)
+			}
+			continue
+		}
+		if len(problems) != 1 || !strings.Contains(problems[0], test.problem) {
+			t.Errorf("%+v should have problem %q but has %v", test.scope, test.problem, problems)
+		}
+	}
+}
+
+func TestScopedRelationInputs(t *testing.T) {
+	comment := &BoilerModel{Name: "Comment", Fields: []*BoilerField{
+		{Name: "ID", ColumnName: "id"},
+		{Name: "PostID", ColumnName: "post_id", IsForeignKey: true},
+		{Name: "UserID", ColumnName: "user_id", IsForeignKey: true},
+	}}
+	applyScopes([]*BoilerModel{comment}, []*Scope{{Column: "user_id", Accessor: "auth.UserIDFromContext"}})
+	input := &Model{Name: "CommentCreateInput", IsCreateInput: true, BoilerModel: comment, Fields: []*Field{
+		{JSONName: "post", BoilerField: *comment.Fields[1], RelationshipInput: &Model{Name: "PostCreateInput"}},
+		{JSONName: "user", BoilerField: *comment.Fields[2], RelationshipInput: &Model{Name: "UserCreateInput"}},
+		{JSONName: "userId", BoilerField: *comment.Fields[2]},
+	}}
+
+	relations := scopedRelationInputs([]*Model{input})
+	expected := "CommentCreateInput.user can not be created since user_id is set by auth.UserIDFromContext"
+	if len(relations) != 1 || relations[0] != expected {
+		t.Errorf("relations should be [%v] but are %v", expected, relations)
+	}
+}
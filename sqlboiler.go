@@ -3,423 +3,695 @@ package gqlgen_sqlboiler
 import (
 	"fmt"
 	"go/ast"
-	"go/parser"
 	"go/token"
-	"io/ioutil"
+	"go/types"
+	"os"
 	"path/filepath"
-	"regexp"
+	"reflect"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 
-	"github.com/iancoleman/strcase"
+	"golang.org/x/tools/go/packages"
 )
 
 type BoilerModel struct {
-	Name                  string
-	TableName             string
-	PluralName            string
-	Fields                []*BoilerField
+	Name string
+	// TableName is the field of the table inside models.TableNames e.g. PostLikes
+	TableName string
+	// DatabaseTableName is the name of the table in the database e.g. post_likes
+	DatabaseTableName string
+	PluralName        string
+	Fields            []*BoilerField
+	PrimaryKeyFields  []*BoilerField
+	// Scopes limit the rows resolvers can see and change to the values in the context, see Scope
+	Scopes []*BoilerScope
+	// CanSoftDelete is true when sqlboiler generated soft deletes for the deleted_at column, deletes set it instead of
+	// removing the row and queries leave out the deleted rows
+	CanSoftDelete bool
+	// Deprecated: use Scopes, these are only set for the columns organization_id, user_organization_id and user_id
 	HasOrganizationID     bool
 	HasUserOrganizationID bool
 	HasUserID             bool
 }
 
 type BoilerField struct {
-	Name             string
-	PluralName       string
-	Type             string
+	Name       string
+	PluralName string
+	Type       string
+	// ColumnName is the name of the column in the database e.g. user_id, empty for relationships
+	ColumnName       string
 	IsForeignKey     bool
+	IsPrimaryKey     bool
 	IsRequired       bool
 	IsArray          bool
 	IsRelation       bool
+	IsManyToMany     bool
 	RelationshipName string
 	Relationship     *BoilerModel
+	// ForeignKey is the field inside the relationship which refers to this model e.g. Comment.PostID for Post.Comments
+	ForeignKey *BoilerField
 }
 
-type BoilerType struct {
-	Name string
-	Type string
-}
-
-// parseModelsAndFieldsFromBoiler since these are like User.ID, User.Organization and we want them grouped by
-// modelName and their belonging fields.
-func GetBoilerModels(dir string) []*BoilerModel {
-	boilerTypeMap, _, boilerTypeOrder := parseBoilerFile(dir)
-	boilerTypes := getSortedBoilerTypes(boilerTypeMap, boilerTypeOrder)
-	tableNames := parseTableNames(dir)
-
-	// sortedModelNames is needed to get the right order back of the models since we want the same order every time
-	// this program has ran.
-	var modelNames []string
-
-	// fieldsPerModelName is needed to group the fields per model, so we can get all fields per modelName later on
-	fieldsPerModelName := map[string][]*BoilerField{}
-	relationsPerModelName := map[string][]*BoilerField{}
-
-	// Anonymous function because this is used 2 times it prevents duplicated code
-	// It's automatically inits an empty field array if it does not exist yet
-	addFieldToMap := func(m map[string][]*BoilerField, modelName string, field *BoilerField) {
-		modelNames = appendIfMissing(modelNames, modelName)
-		_, ok := m[modelName]
+// GetBoilerModels loads the sqlboiler package with its type information. Every struct with a R field holding the
+// relationships (e.g. Post.R *postR) is a model, the columns come from the boil struct tags and the relationships
+// from the R struct and the query methods sqlboiler generates for them.
+func GetBoilerModels(dir string) ([]*BoilerModel, error) {
+	pkg, err := loadBoilerPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+	scope := pkg.Types.Scope()
+	funcDecls := getFuncDecls(pkg)
+	queryFuncNames := getQueryFuncNames(scope)
+	tableNames := getKeyValues(findVarValue(pkg, "TableNames"))
+
+	var models []*BoilerModel
+	relationStructs := map[*BoilerModel]*types.Struct{}
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || !typeName.Exported() {
+			continue
+		}
+		modelStruct, ok := typeName.Type().Underlying().(*types.Struct)
 		if !ok {
-			m[modelName] = []*BoilerField{}
-		}
-		m[modelName] = append(m[modelName], field)
-	}
-
-	// Let's parse boilerTypes to models and fields
-	for _, boiler := range boilerTypes {
-		// split on . input is like model.Field e.g. -> User.ID
-		splitted := strings.Split(boiler.Name, ".")
-		// result in e.g. User
-		modelName := splitted[0]
-		// result in e.g. ID
-		boilerFieldName := splitted[1]
-
-		// handle names with lowercase e.g. userR, userL or other sqlboiler extra's
-		if isFirstCharacterLowerCase(modelName) {
-			// It's the relations of the model
-			// let's add them so we can use them later
-			if strings.HasSuffix(modelName, "R") {
-				modelName = strcase.ToCamel(strings.TrimSuffix(modelName, "R"))
-
-				isArray := strings.HasSuffix(boiler.Type, "Slice")
-				boilerType := strings.TrimSuffix(boiler.Type, "Slice")
-
-				relationField := &BoilerField{
-					Name:             boilerFieldName,
-					RelationshipName: strings.TrimSuffix(boilerFieldName, "ID"),
-					PluralName:       pluralizer.Plural(boilerFieldName),
-					Type:             boilerType,
-					IsRelation:       true,
-					IsRequired:       false,
-					IsArray:          isArray,
-				}
-				addFieldToMap(relationsPerModelName, modelName, relationField)
-			}
-
-			// ignore the default handling since this field is already handled
 			continue
 		}
-
-		// Ignore these since these are sqlboiler helper structs for preloading relationships
-		if boilerFieldName == "L" || boilerFieldName == "R" {
+		relationName, relationStruct := getRelationStruct(modelStruct)
+		if relationStruct == nil {
 			continue
 		}
-		isRelation := strings.HasSuffix(boilerFieldName, "ID") && boilerFieldName != "ID"
+		// e.g. post for postR, postQuery and postPrimaryKeyColumns
+		prefix := strings.TrimSuffix(relationName, "R")
 
-		addFieldToMap(fieldsPerModelName, modelName, &BoilerField{
-			Name:             boilerFieldName,
-			PluralName:       pluralizer.Plural(boilerFieldName),
-			Type:             boiler.Type,
-			IsRelation:       isRelation,
-			IsRequired:       isRequired(boiler.Type),
-			RelationshipName: strings.TrimSuffix(boilerFieldName, "ID"),
-			IsForeignKey:     isRelation,
-		})
-	}
-	sort.Strings(modelNames)
+		pluralName, ok := queryFuncNames[prefix+"Query"]
+		if !ok {
+			pluralName = pluralizer.Plural(name)
+		}
+		databaseTableName := getTableFromQuery(pkg, funcDecls[pluralName])
+		tableName := findTableNameByValue(tableNames, databaseTableName)
+		if tableName == "" {
+			tableName = findTableName(getKeys(tableNames), name)
+		}
 
-	// Let's generate the models in the same order as the sqlboiler structs were parsed
-	models := make([]*BoilerModel, len(modelNames))
-	for i, modelName := range modelNames {
-		fields := fieldsPerModelName[modelName]
-		tableName := findTableName(tableNames, modelName)
-		models[i] = &BoilerModel{
-			Name:                  modelName,
+		fields := getBoilerFields(pkg, modelStruct)
+		primaryKeyColumns := getStringValues(findVarValue(pkg, prefix+"PrimaryKeyColumns"))
+		model := &BoilerModel{
+			Name:                  name,
 			TableName:             tableName,
-			PluralName:            pluralizer.Plural(modelName),
+			DatabaseTableName:     databaseTableName,
+			PluralName:            pluralName,
 			Fields:                fields,
+			PrimaryKeyFields:      findPrimaryKeyFields(fields, getFieldNamesForColumns(fields, primaryKeyColumns)),
+			CanSoftDelete:         canSoftDelete(typeName),
 			HasOrganizationID:     findBoilerField(fields, "OrganizationID") != nil,
 			HasUserOrganizationID: findBoilerField(fields, "UserOrganizationID") != nil,
 			HasUserID:             findBoilerField(fields, "UserID") != nil,
 		}
+		models = append(models, model)
+		relationStructs[model] = relationStruct
 	}
 
 	// let's fill relationship models
 	// We need to this after because we have pointers to relationships
 	for _, model := range models {
-		relationFields := relationsPerModelName[model.Name]
-		for _, relationField := range relationFields {
-			relationship := FindBoilerModel(models, relationField.Type)
-
-			// try to find foreign key inside model
-			foreignKey := findBoilerField(model.Fields, relationField.Name+"ID")
-			if foreignKey != nil {
-				foreignKey.Relationship = relationship
-			} else {
-				// fmt.Println("could not find foreignkey", foreignKey, model.Name, relationField.Name)
-				// this is not a foreign key but a normal relationship
-				relationField.Relationship = relationship
-				model.Fields = append(model.Fields, relationField)
+		relationStruct := relationStructs[model]
+		for i := 0; i < relationStruct.NumFields(); i++ {
+			relation := relationStruct.Field(i)
+			relationshipName, isArray := getRelationshipType(relation.Type())
+			relationship := FindBoilerModel(models, relationshipName)
+			if relationship == nil {
+				fmt.Printf("[WARN] could not find the model %v of relationship %v.%v\n",
+					relationshipName, model.Name, relation.Name())
+				continue
 			}
+			query := getRelationQuery(pkg, funcDecls[model.Name+"."+relation.Name()])
+
+			if !isArray {
+				if foreignKey := findForeignKey(model, relationship, relation.Name(), query); foreignKey != nil {
+					foreignKey.IsRelation = true
+					foreignKey.IsForeignKey = true
+					foreignKey.RelationshipName = relation.Name()
+					foreignKey.Relationship = relationship
+					continue
+				}
+			}
+
+			// this is not a foreign key but a normal relationship
+			relationField := &BoilerField{
+				Name:             relation.Name(),
+				RelationshipName: relation.Name(),
+				PluralName:       pluralizer.Plural(relation.Name()),
+				Type:             relationship.Name,
+				IsRelation:       true,
+				IsRequired:       false,
+				IsArray:          isArray,
+				Relationship:     relationship,
+			}
+			if query.Found {
+				// many-to-many relationships have a join table in between
+				relationField.IsManyToMany = isArray && query.HasJoin
+				if !query.HasJoin {
+					relationField.ForeignKey = findBoilerFieldByColumn(relationship.Fields, query.Column)
+				}
+			}
+			model.Fields = append(model.Fields, relationField)
 		}
 	}
+
+	// without the query methods we can only guess many-to-many relationships by the slices on both sides
 	for _, model := range models {
 		for _, field := range model.Fields {
-			if field.IsRelation && field.Relationship == nil {
-				fmt.Printf("[warn] We could not find the relationship in the generated "+
-					"boiler structs for %v.%v this could result in unexpected behavior, we marked this field as "+
-					"non-relational \n", model.Name, field.Name)
-				field.IsRelation = false
+			if field.IsArray && field.Relationship != nil && field.ForeignKey == nil && !field.IsManyToMany {
+				field.IsManyToMany = hasArrayRelationTo(field.Relationship, model.Name)
 			}
 		}
 	}
 
-	return models
+	return models, nil
 }
 
-func findBoilerField(fields []*BoilerField, fieldName string) *BoilerField {
-	for _, m := range fields {
-		if m.Name == fieldName {
-			return m
-		}
+// canSoftDelete is true when the Delete method of the model takes a hardDelete argument e.g.
+// func (o *Post) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error)
+func canSoftDelete(typeName *types.TypeName) bool {
+	object, _, _ := types.LookupFieldOrMethod(types.NewPointer(typeName.Type()), true, typeName.Pkg(), "Delete")
+	method, ok := object.(*types.Func)
+	if !ok {
+		return false
 	}
-	return nil
+	params := method.Type().(*types.Signature).Params()
+	if params.Len() == 0 {
+		return false
+	}
+	last := params.At(params.Len() - 1)
+	return last.Name() == "hardDelete" && types.Identical(last.Type(), types.Typ[types.Bool])
 }
 
-func findTableName(tableNames []string, modelName string) string {
-	for _, tableName := range tableNames {
-		if modelName == tableName {
-			return tableName
+// boilerPackages holds the loaded sqlboiler packages by directory, the convert and resolver plugin both need them and
+// type checking them together with their dependencies is slow. A package is loaded again when the files in its
+// directory changed e.g. when sqlboiler ran again in the same process.
+var (
+	boilerPackages      = map[string]*boilerPackage{} //nolint:gochecknoglobals
+	boilerPackagesMutex sync.Mutex                    //nolint:gochecknoglobals
+)
+
+type boilerPackage struct {
+	pkg *packages.Package
+	// files is the name, size and modification time of every go file in the directory when it was loaded
+	files string
+}
+
+// goFilesState returns the name, size and modification time of every go file in the directory
+func goFilesState(dir string) (string, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, filename := range filenames {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return "", err
 		}
+		fmt.Fprintf(&b, "%v %v %v\n", filepath.Base(filename), info.Size(), info.ModTime().UnixNano())
 	}
+	return b.String(), nil
+}
 
-	// if database name is plural
-	for _, tableName := range tableNames {
-		if pluralizer.Plural(modelName) == tableName {
-			return tableName
-		}
+func loadBoilerPackage(dir string) (*packages.Package, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
 	}
-	return modelName
+	files, err := goFilesState(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the sqlboiler models in %v: %w", dir, err)
+	}
+	boilerPackagesMutex.Lock()
+	defer boilerPackagesMutex.Unlock()
+	if cached, ok := boilerPackages[dir]; ok && cached.files == files {
+		return cached.pkg, nil
+	}
+	pkgs, err := packages.Load(&packages.Config{
+		Dir:  dir,
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+	}, ".")
+	if err != nil {
+		return nil, fmt.Errorf("could not load the sqlboiler models in %v: %w", dir, err)
+	}
+	if len(pkgs) == 0 || pkgs[0].Types == nil || len(pkgs[0].Syntax) == 0 {
+		return nil, fmt.Errorf("no go package found in %v", dir)
+	}
+	pkg := pkgs[0]
+	var errs GenerateErrors
+	for _, pkgErr := range pkg.Errors {
+		errs.Append("", fmt.Errorf("sqlboiler models contain an error: %w", pkgErr))
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	boilerPackages[dir] = &boilerPackage{pkg: pkg, files: files}
+	return pkg, nil
 }
 
-func FindBoilerModel(models []*BoilerModel, modelName string) *BoilerModel {
-	for _, m := range models {
-		if m.Name == modelName {
-			return m
+// getRelationStruct returns the struct of the relationships e.g. postR for Post.R
+func getRelationStruct(modelStruct *types.Struct) (string, *types.Struct) {
+	for i := 0; i < modelStruct.NumFields(); i++ {
+		field := modelStruct.Field(i)
+		if field.Name() != "R" {
+			continue
+		}
+		pointer, ok := field.Type().(*types.Pointer)
+		if !ok {
+			return "", nil
+		}
+		named, ok := pointer.Elem().(*types.Named)
+		if !ok {
+			return "", nil
+		}
+		relationStruct, ok := named.Underlying().(*types.Struct)
+		if !ok {
+			return "", nil
 		}
+		return named.Obj().Name(), relationStruct
 	}
-	return nil
+	return "", nil
 }
 
-func isRequired(boilerType string) bool {
-	if strings.HasPrefix(boilerType, "null.") || strings.HasPrefix(boilerType, "*") {
-		return false
+// getRelationshipType returns the model of the relationship e.g. User for *User and Comment for CommentSlice
+func getRelationshipType(t types.Type) (string, bool) {
+	isArray := false
+	if named, ok := t.(*types.Named); ok {
+		if slice, ok := named.Underlying().(*types.Slice); ok {
+			isArray = true
+			t = slice.Elem()
+		}
 	}
-	return true
+	if slice, ok := t.(*types.Slice); ok {
+		isArray = true
+		t = slice.Elem()
+	}
+	if pointer, ok := t.(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+	if named, ok := t.(*types.Named); ok {
+		return named.Obj().Name(), isArray
+	}
+	return "", isArray
 }
 
-func isFirstCharacterLowerCase(s string) bool {
-	if len(s) > 0 && s[0] == strings.ToLower(s)[0] {
-		return true
+func getBoilerFields(pkg *packages.Package, modelStruct *types.Struct) []*BoilerField {
+	// types of the models package are used without package name e.g. PostStatus, others like null.String
+	qualifier := func(other *types.Package) string {
+		if other == pkg.Types {
+			return ""
+		}
+		return other.Name()
 	}
-	return false
+
+	var fields []*BoilerField
+	for i := 0; i < modelStruct.NumFields(); i++ {
+		field := modelStruct.Field(i)
+		columnName := strings.Split(reflect.StructTag(modelStruct.Tag(i)).Get("boil"), ",")[0]
+		if !field.Exported() || columnName == "" || columnName == "-" {
+			continue
+		}
+		boilerType := types.TypeString(field.Type(), qualifier)
+		fields = append(fields, &BoilerField{
+			Name:       field.Name(),
+			PluralName: pluralizer.Plural(field.Name()),
+			Type:       boilerType,
+			ColumnName: columnName,
+			IsRequired: isRequired(boilerType),
+		})
+	}
+
+	// order same way as sqlboiler fields with one exception
+	// let createdAt, updatedAt and deletedAt as last
+	sort.SliceStable(fields, func(i, b int) bool {
+		return getTimestampOrder(fields[i].Name) < getTimestampOrder(fields[b].Name)
+	})
+	return fields
 }
 
-func appendIfMissing(slice []string, v string) []string {
-	if sliceContains(slice, v) {
-		return slice
+func getTimestampOrder(fieldName string) int {
+	for i, timestamp := range []string{"createdat", "updatedat", "deletedat"} {
+		if strings.HasSuffix(strings.ToLower(fieldName), timestamp) {
+			return i + 1
+		}
 	}
-	return append(slice, v)
+	return 0
 }
 
-func sliceContains(slice []string, v string) bool {
-	for _, s := range slice {
-		if s == v {
-			return true
+// getFuncDecls returns the functions and methods of the package e.g. Posts and Post.User
+func getFuncDecls(pkg *packages.Package) map[string]*ast.FuncDecl {
+	funcDecls := map[string]*ast.FuncDecl{}
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+			name := funcDecl.Name.Name
+			if funcDecl.Recv != nil && len(funcDecl.Recv.List) > 0 {
+				recv := funcDecl.Recv.List[0].Type
+				if star, isStar := recv.(*ast.StarExpr); isStar {
+					recv = star.X
+				}
+				ident, ok := recv.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				name = ident.Name + "." + name
+			}
+			funcDecls[name] = funcDecl
 		}
 	}
-	return false
+	return funcDecls
 }
 
-// getSortedBoilerTypes orders the sqlboiler struct in an ordered slice of BoilerType
-func getSortedBoilerTypes(boilerTypeMap map[string]string, boilerTypeOrder map[string]int) (
-	sortedBoilerTypes []*BoilerType) {
-	boilerTypeKeys := make([]string, 0, len(boilerTypeMap))
-	for k := range boilerTypeMap {
-		boilerTypeKeys = append(boilerTypeKeys, k)
+// getQueryFuncNames returns the function which starts a query per query type e.g. postQuery: Posts
+func getQueryFuncNames(scope *types.Scope) map[string]string {
+	queryFuncNames := map[string]string{}
+	for _, name := range scope.Names() {
+		function, ok := scope.Lookup(name).(*types.Func)
+		if !ok || !function.Exported() {
+			continue
+		}
+		signature, ok := function.Type().(*types.Signature)
+		if !ok || !signature.Variadic() || signature.Results().Len() != 1 {
+			continue
+		}
+		if named, ok := signature.Results().At(0).Type().(*types.Named); ok {
+			queryFuncNames[named.Obj().Name()] = name
+		}
 	}
+	return queryFuncNames
+}
 
-	// order same way as sqlboiler fields with one exception
-	// let createdAt, updatedAt and deletedAt as last
-	sort.Slice(boilerTypeKeys, func(i, b int) bool {
-		aKey := boilerTypeKeys[i]
-		bKey := boilerTypeKeys[b]
+// getTableFromQuery returns the table of qm.From("\"posts\"") inside e.g. Posts(mods ...qm.QueryMod)
+func getTableFromQuery(pkg *packages.Package, funcDecl *ast.FuncDecl) string {
+	var table string
+	inspectQueryMods(pkg, funcDecl, func(name string, args []ast.Expr) {
+		if name != "From" || len(args) != 1 {
+			return
+		}
+		if from, ok := stringLiteral(args[0]); ok {
+			table = unquoteIdentifier(from)
+		}
+	})
+	return table
+}
 
-		aOrder := boilerTypeOrder[aKey]
-		bOrder := boilerTypeOrder[bKey]
+// relationQuery is what the query method of a relationship tells us e.g. Post.User has
+// qm.Where("\"id\" = ?", o.UserID) and Post.Comments has qm.Where("\"comments\".\"post_id\"=?", o.ID)
+type relationQuery struct {
+	Found bool
+	// Column of the relationship e.g. id or post_id
+	Column string
+	// Field of the model e.g. UserID or ID
+	Field string
+	// HasJoin is true when a join table is used, e.g. for many-to-many relationships
+	HasJoin bool
+}
 
-		higherOrders := []string{"createdat", "updatedat", "deletedat"}
-		for i, higherOrder := range higherOrders {
-			if strings.HasSuffix(strings.ToLower(aKey), higherOrder) {
-				aOrder += 1000000 + i
+func getRelationQuery(pkg *packages.Package, funcDecl *ast.FuncDecl) relationQuery {
+	var query relationQuery
+	inspectQueryMods(pkg, funcDecl, func(name string, args []ast.Expr) {
+		switch name {
+		case "InnerJoin":
+			query.HasJoin = true
+		case "Where":
+			if len(args) != 2 {
+				return
+			}
+			condition, ok := stringLiteral(args[0])
+			if !ok {
+				return
 			}
-			if strings.HasSuffix(strings.ToLower(bKey), higherOrder) {
-				bOrder += 10000000 + i
+			selector, ok := args[1].(*ast.SelectorExpr)
+			if !ok {
+				return
 			}
+			if selection, ok := pkg.TypesInfo.Selections[selector]; !ok || selection.Kind() != types.FieldVal {
+				return
+			}
+			query.Found = true
+			query.Column = unquoteIdentifier(strings.Split(condition, "=")[0])
+			query.Field = selector.Sel.Name
 		}
-
-		return aOrder < bOrder
 	})
+	return query
+}
 
-	for _, modelAndField := range boilerTypeKeys {
-		// fmt.Println(modelAndField)
-		sortedBoilerTypes = append(sortedBoilerTypes, &BoilerType{
-			Name: modelAndField,
-			Type: boilerTypeMap[modelAndField],
-		})
+// inspectQueryMods calls fn for every qm call inside the function e.g. qm.Where
+func inspectQueryMods(pkg *packages.Package, funcDecl *ast.FuncDecl, fn func(name string, args []ast.Expr)) {
+	if funcDecl == nil {
+		return
 	}
-	return //nolint:nakedret
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		function, ok := pkg.TypesInfo.Uses[selector.Sel].(*types.Func)
+		if !ok || function.Pkg() == nil || !strings.HasSuffix(function.Pkg().Path(), "queries/qm") {
+			return true
+		}
+		fn(function.Name(), call.Args)
+		return true
+	})
 }
 
-var tableNameRegex = regexp.MustCompile(`\s*(.*[^ ])\s*string`) //nolint:gochecknoglobals
-
-func parseTableNames(dir string) []string {
-	dir, err := filepath.Abs(dir)
-	errMessage := "[WARN] could not open boiler table names file, this could not lead to problems if you're " +
-		"using plural table names"
-	if err != nil {
-		fmt.Println(errMessage, err)
-		return nil
+// findForeignKey returns the field which refers to the to-one relationship, this is nil when the relationship refers
+// to this model instead e.g. a user with one profile
+func findForeignKey(model, relationship *BoilerModel, relationName string, query relationQuery) *BoilerField {
+	if !query.Found {
+		// try to find foreign key inside model
+		return findBoilerField(model.Fields, relationName+"ID")
 	}
-	content, err := ioutil.ReadFile(filepath.Join(dir, "boil_table_names.go"))
-	if err != nil {
-		fmt.Println(errMessage, err)
+	field := findBoilerField(model.Fields, query.Field)
+	if field == nil {
 		return nil
 	}
-	tableNamesMatches := tableNameRegex.FindAllStringSubmatch(string(content), -1)
-	tableNames := make([]string, len(tableNamesMatches))
-	for i, tableNameMatch := range tableNamesMatches {
-		tableNames[i] = tableNameMatch[1]
+	// e.g. Post.User is on user.id = post.user_id but User.Profile is on profile.user_id = user.id
+	relationshipColumn := findBoilerFieldByColumn(relationship.Fields, query.Column)
+	if field.IsPrimaryKey && (relationshipColumn == nil || !relationshipColumn.IsPrimaryKey) {
+		return nil
 	}
-	return tableNames
+	return field
 }
 
-// will return StructName.key
-// e.g.
-// Address.ID: null.Integer
-// Address.Longitude: null.String
-// Address.Latitude : null.Decimal
-// needed to generate the right convert code
-func parseBoilerFile(dir string) (map[string]string, map[string]string, map[string]int) {
-	fieldsMap := make(map[string]string)
-	structsMap := make(map[string]string)
-	fieldsOrder := make(map[string]int)
+// findVarValue returns the value of a package variable e.g. TableNames or postPrimaryKeyColumns
+func findVarValue(pkg *packages.Package, name string) *ast.CompositeLit {
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				valueSpec, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+				for i, ident := range valueSpec.Names {
+					if ident.Name != name || i >= len(valueSpec.Values) {
+						continue
+					}
+					compositeLit, _ := valueSpec.Values[i].(*ast.CompositeLit)
+					return compositeLit
+				}
+			}
+		}
+	}
+	return nil
+}
 
-	dir, err := filepath.Abs(dir)
-	if err != nil {
-		fmt.Println("abs error", err)
-		return fieldsMap, structsMap, fieldsOrder
+// getStringValues returns the values of e.g. []string{"post_id", "tag_id"}
+func getStringValues(compositeLit *ast.CompositeLit) []string {
+	if compositeLit == nil {
+		return nil
 	}
-	files, err := ioutil.ReadDir(dir)
-	if err != nil {
-		fmt.Println("readdir error", err)
-		return fieldsMap, structsMap, fieldsOrder
+	var values []string
+	for _, elt := range compositeLit.Elts {
+		if value, ok := stringLiteral(elt); ok {
+			values = append(values, value)
+		}
 	}
+	return values
+}
 
-	fset := token.NewFileSet()
-	for _, file := range files {
-		// only pick .go files and ignore test files
-		if !strings.HasSuffix(strings.ToLower(file.Name()), ".go") ||
-			strings.HasSuffix(strings.ToLower(file.Name()), "_test.go") {
+// getKeyValues returns the keys with their value of e.g. struct{...}{PostLikes: "post_likes"}
+func getKeyValues(compositeLit *ast.CompositeLit) [][2]string {
+	if compositeLit == nil {
+		return nil
+	}
+	var keyValues [][2]string
+	for _, elt := range compositeLit.Elts {
+		keyValue, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := keyValue.Key.(*ast.Ident)
+		if !ok {
 			continue
 		}
+		if value, ok := stringLiteral(keyValue.Value); ok {
+			keyValues = append(keyValues, [2]string{key.Name, value})
+		}
+	}
+	return keyValues
+}
 
-		filename := filepath.Join(dir, file.Name())
-		if src, err := parser.ParseFile(fset, filename, nil, parser.ParseComments); err == nil { //nolint:nestif
-			var i int
-			for _, decl := range src.Decls {
-				typeDecl, ok := decl.(*ast.GenDecl)
-				if !ok {
-					continue
-				}
+func getKeys(keyValues [][2]string) []string {
+	keys := make([]string, len(keyValues))
+	for i, keyValue := range keyValues {
+		keys[i] = keyValue[0]
+	}
+	return keys
+}
 
-				for _, spec := range typeDecl.Specs {
-					safeTypeSpec, ok := spec.(*ast.TypeSpec)
-					if !ok {
-						continue
-					}
+func findTableNameByValue(tableNames [][2]string, databaseTableName string) string {
+	if databaseTableName == "" {
+		return ""
+	}
+	for _, tableName := range tableNames {
+		if tableName[1] == databaseTableName {
+			return tableName[0]
+		}
+	}
+	return ""
+}
 
-					structsMap[safeTypeSpec.Name.String()] = safeTypeSpec.Name.String()
+// unquoteIdentifier returns the identifier without quotes and schema e.g. post_id for "comments"."post_id"
+func unquoteIdentifier(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.LastIndex(s, "."); i >= 0 {
+		s = s[i+1:]
+	}
+	return strings.Trim(s, "\"`[] ")
+}
 
-					safeStructDecl, ok := safeTypeSpec.Type.(*ast.StructType)
-					if !ok {
-						continue
-					}
+func stringLiteral(expr ast.Expr) (string, bool) {
+	basicLit, ok := expr.(*ast.BasicLit)
+	if !ok || basicLit.Kind != token.STRING {
+		return "", false
+	}
+	v, err := strconv.Unquote(basicLit.Value)
+	if err != nil {
+		return "", false
+	}
+	return v, true
+}
 
-					for _, field := range safeStructDecl.Fields.List {
-						switch xv := field.Type.(type) {
-						case *ast.StarExpr:
+func findBoilerField(fields []*BoilerField, fieldName string) *BoilerField {
+	for _, m := range fields {
+		if m.Name == fieldName {
+			return m
+		}
+	}
+	return nil
+}
 
-							if len(field.Names) > 0 {
-								name := field.Names[0].Name
+func findBoilerFieldByColumn(fields []*BoilerField, columnName string) *BoilerField {
+	for _, m := range fields {
+		if m.ColumnName != "" && m.ColumnName == columnName {
+			return m
+		}
+	}
+	return nil
+}
 
-								if si, ok := xv.X.(*ast.Ident); ok {
-									k := safeTypeSpec.Name.String() + "." + name
-									//https://stackoverflow.com/questions/28246970/how-to-parse-a-method-declaration
-									fieldsMap[k] = si.Name
-									fieldsOrder[k] = i
-								}
-							} // else {
-							// fmt.Println("len(field.Names) == 0", field)
-						//	}
+func getFieldNamesForColumns(fields []*BoilerField, columnNames []string) []string {
+	var fieldNames []string
+	for _, columnName := range columnNames {
+		if field := findBoilerFieldByColumn(fields, columnName); field != nil {
+			fieldNames = append(fieldNames, field.Name)
+		}
+	}
+	return fieldNames
+}
 
-						case *ast.SelectorExpr:
-							t, _ := field.Type.(*ast.SelectorExpr)
-							name := field.Names[0].Name
+func hasArrayRelationTo(model *BoilerModel, modelName string) bool {
+	for _, field := range model.Fields {
+		if field.IsArray && field.Type == modelName {
+			return true
+		}
+	}
+	return false
+}
 
-							k := safeTypeSpec.Name.String() + "." + name
+// findPrimaryKeyFields marks the fields of the primary key, if sqlboiler did not tell us we expect it to be ID
+func findPrimaryKeyFields(fields []*BoilerField, primaryKeyNames []string) []*BoilerField {
+	if len(primaryKeyNames) == 0 {
+		primaryKeyNames = []string{"ID"}
+	}
+	var primaryKeyFields []*BoilerField
+	for _, name := range primaryKeyNames {
+		field := findBoilerField(fields, name)
+		if field == nil {
+			continue
+		}
+		field.IsPrimaryKey = true
+		primaryKeyFields = append(primaryKeyFields, field)
+	}
+	return primaryKeyFields
+}
+
+// PrimaryKey is the only field of the primary key, this is nil for composite primary keys
+func (m *BoilerModel) PrimaryKey() *BoilerField {
+	if len(m.PrimaryKeyFields) != 1 {
+		return nil
+	}
+	return m.PrimaryKeyFields[0]
+}
 
-							fieldsMap[k] = t.X.(*ast.Ident).Name + "." + t.Sel.Name
-							fieldsOrder[k] = i
+// PrimaryKeyName is the field name of the primary key e.g. ID or PostUUID
+func (m *BoilerModel) PrimaryKeyName() string {
+	if primaryKey := m.PrimaryKey(); primaryKey != nil {
+		return primaryKey.Name
+	}
+	return "ID"
+}
 
-						case *ast.Ident:
+func (m *BoilerModel) HasCompositePrimaryKey() bool {
+	return len(m.PrimaryKeyFields) > 1
+}
 
-							t, _ := field.Type.(*ast.Ident) // The type as a string
-							typeName := t.Name
-							name := field.Names[0].Name // name as a string
+func findTableName(tableNames []string, modelName string) string {
+	for _, tableName := range tableNames {
+		if modelName == tableName {
+			return tableName
+		}
+	}
 
-							k := safeTypeSpec.Name.String() + "." + name
-							fieldsMap[k] = typeName
-							fieldsOrder[k] = i
+	// if database name is plural
+	for _, tableName := range tableNames {
+		if pluralizer.Plural(modelName) == tableName {
+			return tableName
+		}
+	}
+	return modelName
+}
 
-						default:
-							// fmt.Println("ignoring....", field.Names)
-						}
-						i++
-					}
-				}
-			}
+func FindBoilerModel(models []*BoilerModel, modelName string) *BoilerModel {
+	for _, m := range models {
+		if m.Name == modelName {
+			return m
 		}
 	}
+	return nil
+}
 
-	// To store the keys in slice in sorted order
-	// var keys []string
-	// for k := range fieldsMap {
-	// 	keys = append(keys, k)
-	// }
-	// sort.Strings(keys)
-
-	// fmt.Println(" ")
-	// fmt.Println(" ")
-	// fmt.Println(" ")
-	// fmt.Println("START OF MAP DUMP")
-	// fmt.Println("START OF MAP DUMP")
-	// fmt.Println("START OF MAP DUMP")
-	// fmt.Println(" ")
-	// fmt.Println(" ")
-	// for _, key := range keys {
-	// 	fmt.Println(key, ":", fieldsMap[key])
-	// }
-	// fmt.Println(" ")
-	// fmt.Println(" ")
-	// fmt.Println("END OF MAP DUMP")
-	// fmt.Println("END OF MAP DUMP")
-	// fmt.Println("END OF MAP DUMP")
-	// fmt.Println(" ")
-	// fmt.Println(" ")
-	// fmt.Println(" ")
-
-	return fieldsMap, structsMap, fieldsOrder
+func isRequired(boilerType string) bool {
+	if strings.HasPrefix(boilerType, "null.") || strings.HasPrefix(boilerType, "*") {
+		return false
+	}
+	return true
 }
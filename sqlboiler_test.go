@@ -0,0 +1,136 @@
+package gqlgen_sqlboiler
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testdata/blog/models is generated by sqlboiler v4.5.0 from testdata/blog/schema.sql with testdata/blog/sqlboiler.toml
+const blogModelsDirectory = "testdata/blog/models"
+
+func TestGetBoilerModels(t *testing.T) {
+	models, err := GetBoilerModels(blogModelsDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, model := range models {
+		names = append(names, model.Name)
+	}
+	expectedNames := []string{"Comment", "Organization", "Post", "PostLike", "Setting", "Tag", "User"}
+	if !reflect.DeepEqual(names, expectedNames) {
+		t.Fatalf("models should be %v but are %v", expectedNames, names)
+	}
+
+	post := FindBoilerModel(models, "Post")
+	if post.TableName != "Posts" || post.DatabaseTableName != "posts" || post.PluralName != "Posts" {
+		t.Errorf("post has table name %v, database table name %v and plural name %v",
+			post.TableName, post.DatabaseTableName, post.PluralName)
+	}
+	if !post.CanSoftDelete {
+		t.Errorf("post has a deleted_at column so it should be soft deletable")
+	}
+	if post.PrimaryKeyName() != "ID" || post.HasCompositePrimaryKey() {
+		t.Errorf("post should have primary key ID but has %v", post.PrimaryKeyFields)
+	}
+	if !post.HasUserID || !post.HasOrganizationID {
+		t.Errorf("post should have a user and an organization id")
+	}
+
+	testBoilerField(t, post, "Title", "string", "title")
+	testBoilerField(t, post, "DeletedAt", "null.Time", "deleted_at")
+	userID := testBoilerField(t, post, "UserID", "uint", "user_id")
+	if !userID.IsForeignKey || !userID.IsRelation || userID.Relationship != FindBoilerModel(models, "User") {
+		t.Errorf("post.UserID should be a foreign key to user")
+	}
+	comments := testBoilerField(t, post, "Comments", "Comment", "")
+	if !comments.IsRelation || !comments.IsArray || comments.IsManyToMany {
+		t.Errorf("post.Comments should be a one to many relation")
+	}
+	if comments.ForeignKey == nil || comments.ForeignKey.Name != "PostID" {
+		t.Errorf("post.Comments should have foreign key PostID but has %+v", comments.ForeignKey)
+	}
+	tags := testBoilerField(t, post, "Tags", "Tag", "")
+	if !tags.IsRelation || !tags.IsArray || !tags.IsManyToMany || tags.Relationship != FindBoilerModel(models, "Tag") {
+		t.Errorf("post.Tags should be a many to many relation through post_tags")
+	}
+
+	for _, name := range []string{"Comment", "PostLike", "Setting", "Tag", "User"} {
+		if FindBoilerModel(models, name).CanSoftDelete {
+			t.Errorf("%v has no deleted_at column so it should not be soft deletable", name)
+		}
+	}
+
+	postLike := FindBoilerModel(models, "PostLike")
+	if !postLike.HasCompositePrimaryKey() ||
+		!reflect.DeepEqual(boilerFieldNames(postLike.PrimaryKeyFields), []string{"PostID", "UserID"}) {
+		t.Errorf("post like should have primary key PostID, UserID but has %v",
+			boilerFieldNames(postLike.PrimaryKeyFields))
+	}
+	if postLikes := findBoilerField(post.Fields, "PostLikes"); postLikes == nil || postLikes.ForeignKey.Name != "PostID" {
+		t.Errorf("post.PostLikes should be a relation by PostID")
+	}
+
+	setting := FindBoilerModel(models, "Setting")
+	testBoilerField(t, setting, "Key", "string", "key")
+	if setting.PrimaryKeyName() != "Key" {
+		t.Errorf("setting should have primary key Key but has %v", setting.PrimaryKeyName())
+	}
+}
+
+func TestLoadBoilerPackageOnce(t *testing.T) {
+	first, err := loadBoilerPackage(blogModelsDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := loadBoilerPackage("./" + blogModelsDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Errorf("sqlboiler models should only be loaded once")
+	}
+
+	// sqlboiler generated the models again
+	filename := filepath.Join(blogModelsDirectory, "posts.go")
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	modTime := info.ModTime()
+	defer os.Chtimes(filename, modTime, modTime) //nolint:errcheck
+	if err := os.Chtimes(filename, modTime, modTime.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	third, err := loadBoilerPackage(blogModelsDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third == first {
+		t.Errorf("sqlboiler models should be loaded again after they changed")
+	}
+}
+
+func testBoilerField(t *testing.T, model *BoilerModel, name, typ, columnName string) *BoilerField {
+	t.Helper()
+	field := findBoilerField(model.Fields, name)
+	if field == nil {
+		t.Fatalf("%v should have field %v", model.Name, name)
+	}
+	if field.Type != typ || field.ColumnName != columnName {
+		t.Errorf("%v.%v should have type %v and column %q but has %v and %q",
+			model.Name, name, typ, columnName, field.Type, field.ColumnName)
+	}
+	return field
+}
+
+func boilerFieldNames(fields []*BoilerField) []string {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name
+	}
+	return names
+}
@@ -0,0 +1,84 @@
+package gqlgen_sqlboiler
+
+import (
+	"embed"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// templateFiles are shipped inside the package so generating also works when vendored, built with -trimpath or
+// loaded from a read-only module cache
+//
+//go:embed *.gotpl
+var templateFiles embed.FS
+
+// getTemplate returns the embedded template
+func getTemplate(filename string) (string, error) {
+	content, err := templateFiles.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("could not read template: %w", err)
+	}
+	return string(content), nil
+}
+
+var definePattern = regexp.MustCompile(`{{-?\s*define\s+"([^"]+)"\s*-?}}`) //nolint:gochecknoglobals
+
+// loadTemplate returns the template with the overrides of the template directory. A file with the same name e.g.
+// resolver.gotpl replaces the whole template, the files in a directory named after the template e.g.
+// resolver/errors.gotpl replace the partials they define e.g. {{ define "returnError" }}. The built-in partial is
+// still available as e.g. {{ template "builtin:returnError" . }}.
+func loadTemplate(templateDirectory, filename string) (string, error) {
+	if templateDirectory == "" {
+		return getTemplate(filename)
+	}
+
+	var template string
+	content, err := ioutil.ReadFile(filepath.Join(templateDirectory, filename))
+	switch {
+	case err == nil:
+		template = string(content)
+	case os.IsNotExist(err):
+		template, err = getTemplate(filename)
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("could not read template: %w", err)
+	}
+
+	// Glob sorts the files so the result is the same every time
+	partialFiles, err := filepath.Glob(filepath.Join(templateDirectory, strings.TrimSuffix(filename, ".gotpl"), "*.gotpl"))
+	if err != nil {
+		return "", err
+	}
+	definedIn := map[string]string{}
+	for _, partialFile := range partialFiles {
+		content, err := ioutil.ReadFile(partialFile)
+		if err != nil {
+			return "", fmt.Errorf("could not read template: %w", err)
+		}
+		matches := definePattern.FindAllStringSubmatch(string(content), -1)
+		if len(matches) == 0 {
+			return "", fmt.Errorf("%v should contain partials e.g. {{ define \"name\" }}...{{ end }}", partialFile)
+		}
+		for _, match := range matches {
+			name := match[1]
+			if otherFile, ok := definedIn[name]; ok {
+				return "", fmt.Errorf("partial %v is defined in %v and %v", name, otherFile, partialFile)
+			}
+			definedIn[name] = partialFile
+			template = renameDefinedTemplate(template, name, "builtin:"+name)
+		}
+		template += "\n" + string(content)
+	}
+	return template, nil
+}
+
+func renameDefinedTemplate(template, name, newName string) string {
+	pattern := regexp.MustCompile(`({{-?\s*define\s+)"` + regexp.QuoteMeta(name) + `"`)
+	return pattern.ReplaceAllString(template, `${1}"`+newName+`"`)
+}
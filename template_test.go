@@ -0,0 +1,76 @@
+package gqlgen_sqlboiler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetTemplate(t *testing.T) {
+	// every template next to the source has to be embedded
+	filenames, err := filepath.Glob("*.gotpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filenames) < 8 {
+		t.Fatalf("expected every template but found %v", filenames)
+	}
+	for _, filename := range filenames {
+		content, err := getTemplate(filename)
+		if err != nil {
+			t.Errorf("%v should be embedded but resulted in %v", filename, err)
+		}
+		if !strings.Contains(content, "reserveImport") {
+			t.Errorf("%v should contain the template", filename)
+		}
+	}
+	if _, err := getTemplate("unknown.gotpl"); err == nil {
+		t.Errorf("unknown.gotpl should result in an error")
+	}
+}
+
+func TestLoadTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gqlgen-sqlboiler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "resolver"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeTemplate(t, filepath.Join(dir, "resolver", "errors.gotpl"),
+		`{{ define "returnError" }}customError{{ template "builtin:returnError" . }}{{ end }}`)
+	writeTemplate(t, filepath.Join(dir, "convert.gotpl"), `whole template`)
+
+	resolver, err := loadTemplate(dir, "resolver.gotpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(resolver, `define "builtin:returnError"`) || !strings.Contains(resolver, "customError") {
+		t.Errorf("returnError should be overridden and the built-in one renamed")
+	}
+	if strings.Count(resolver, `define "returnError"`) != 1 {
+		t.Errorf("returnError should be defined once otherwise the template does not parse")
+	}
+
+	convert, err := loadTemplate(dir, "convert.gotpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if convert != "whole template" {
+		t.Errorf("convert.gotpl should be replaced but is %v", convert)
+	}
+
+	writeTemplate(t, filepath.Join(dir, "resolver", "other.gotpl"), `{{ define "returnError" }}{{ end }}`)
+	if _, err := loadTemplate(dir, "resolver.gotpl"); err == nil {
+		t.Errorf("a partial defined twice should result in an error")
+	}
+}
+
+func writeTemplate(t *testing.T, filename, content string) {
+	if err := ioutil.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
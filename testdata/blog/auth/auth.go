@@ -0,0 +1,26 @@
+// Package auth is the auth_import of testdata/blog, the generated resolvers scope their queries with it
+package auth
+
+import "context"
+
+type contextKey string
+
+const (
+	organizationIDKey contextKey = "organization_id"
+	userIDKey         contextKey = "user_id"
+)
+
+func WithUser(ctx context.Context, organizationID, userID uint) context.Context {
+	ctx = context.WithValue(ctx, organizationIDKey, organizationID)
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+func OrganizationIDFromContext(ctx context.Context) uint {
+	id, _ := ctx.Value(organizationIDKey).(uint)
+	return id
+}
+
+func UserIDFromContext(ctx context.Context) uint {
+	id, _ := ctx.Value(userIDKey).(uint)
+	return id
+}
@@ -0,0 +1,1549 @@
+// Generated with https://github.com/web-ridge/gqlgen-sqlboiler.
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"example.com/blog/auth"
+	fm "example.com/blog/graphql_models"
+	. "example.com/blog/helpers"
+	dm "example.com/blog/models"
+	"github.com/rs/zerolog/log"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/web-ridge/utils-go/boilergql"
+)
+
+type Resolver struct {
+	db                 DB
+	reader             boil.ContextExecutor
+	logger             Logger
+	commentAuthorizer  CommentAuthorizer
+	postAuthorizer     PostAuthorizer
+	postLikeAuthorizer PostLikeAuthorizer
+	settingAuthorizer  SettingAuthorizer
+	tagAuthorizer      TagAuthorizer
+}
+
+type ResolverOption func(r *Resolver)
+
+// WithReader lets queries use e.g. a read replica, mutations and the payloads after them always use the writer
+func WithReader(reader boil.ContextExecutor) ResolverOption {
+	return func(r *Resolver) {
+		r.reader = reader
+	}
+}
+
+// WithLogger logs the errors of the resolvers with your own logger instead of zerolog
+func WithLogger(logger Logger) ResolverOption {
+	return func(r *Resolver) {
+		r.logger = logger
+	}
+}
+
+// NewResolver creates the resolvers, use SQLDB to pass a *sql.DB
+func NewResolver(db DB, options ...ResolverOption) *Resolver {
+	r := &Resolver{
+		db:                 db,
+		logger:             ZerologLogger{},
+		commentAuthorizer:  AllowAllCommentAuthorizer{},
+		postAuthorizer:     AllowAllPostAuthorizer{},
+		postLikeAuthorizer: AllowAllPostLikeAuthorizer{},
+		settingAuthorizer:  AllowAllSettingAuthorizer{},
+		tagAuthorizer:      AllowAllTagAuthorizer{},
+	}
+	for _, option := range options {
+		option(r)
+	}
+	return r
+}
+
+// readDB returns the reader unless the writer is forced with WithPrimary
+func (r *Resolver) readDB(ctx context.Context) boil.ContextExecutor {
+	if r.reader == nil || IsPrimaryForced(ctx) {
+		return r.db
+	}
+	return r.reader
+}
+
+// ResolverError is an error of a generated resolver, the client only gets the public message and the code
+type ResolverError struct {
+	Model string
+	// Operation is what the resolver does e.g. create or batchDelete
+	Operation     string
+	PublicMessage string
+	// Code is e.g. NOT_FOUND, see ErrorCode
+	Code string
+	Err  error
+}
+
+func (e *ResolverError) Error() string {
+	return e.PublicMessage + ": " + e.Err.Error()
+}
+
+func (e *ResolverError) Unwrap() error {
+	return e.Err
+}
+
+// Logger logs the errors of the resolvers, ctx is the context of the request so it can add e.g. the request id
+type Logger interface {
+	LogError(ctx context.Context, err *ResolverError)
+}
+
+// LoggerFunc lets a function be the Logger
+type LoggerFunc func(ctx context.Context, err *ResolverError)
+
+func (f LoggerFunc) LogError(ctx context.Context, err *ResolverError) {
+	f(ctx, err)
+}
+
+// ZerologLogger logs with the global zerolog logger, it is used until you pass your own with WithLogger
+type ZerologLogger struct{}
+
+func (ZerologLogger) LogError(ctx context.Context, err *ResolverError) {
+	log.Error().Err(err.Err).Str("model", err.Model).Str("operation", err.Operation).Str("code", err.Code).
+		Msg(err.PublicMessage)
+}
+
+// logError logs the error and returns the public message with the code of the error, the details of the error
+// stay out of the response
+func (r *Resolver) logError(ctx context.Context, model, operation, publicMessage string, err error) *gqlerror.Error {
+	code := ErrorCode(err)
+	r.logger.LogError(ctx, &ResolverError{
+		Model:         model,
+		Operation:     operation,
+		PublicMessage: publicMessage,
+		Code:          code,
+		Err:           err,
+	})
+	return NewPublicError(code, publicMessage)
+}
+
+// logInputError is logError for mutations with an input, the fields on which a constraint failed are added to the
+// extensions e.g. "fields": [{"field": "email", "code": "CONFLICT"}]
+func (r *Resolver) logInputError(
+	ctx context.Context,
+	model, operation, publicMessage string,
+	err error,
+	fields InputFields,
+) *gqlerror.Error {
+	publicError := r.logError(ctx, model, operation, publicMessage, err)
+	if fieldErrors := FieldErrors(err, fields); len(fieldErrors) > 0 {
+		publicError.Extensions["fields"] = fieldErrors
+	}
+	return publicError
+}
+
+// logNestedInputError is logInputError for a nested input or a row of a batch, the fields are prefixed with e.g.
+// user or 0 like the validation errors
+func (r *Resolver) logNestedInputError(
+	ctx context.Context,
+	model, operation, publicMessage string,
+	err error,
+	prefix string,
+	fields InputFields,
+) *gqlerror.Error {
+	publicError := r.logError(ctx, model, operation, publicMessage, err)
+	if fieldErrors := FieldErrors(err, fields); len(fieldErrors) > 0 {
+		publicError.Extensions["fields"] = PrefixFieldErrors(prefix, fieldErrors)
+	}
+	return publicError
+}
+
+// forbiddenError returns the error of an authorizer to the client, FORBIDDEN is used when it has no code
+func forbiddenError(err error) error {
+	var publicError *gqlerror.Error
+	if errors.As(err, &publicError) {
+		return err
+	}
+	return NewPublicError(ErrorCodeForbidden, err.Error())
+}
+
+// CommentAuthorizer is asked by the generated resolvers before they resolve comments, errors are
+// shown to the client with the FORBIDDEN code. Embed AllowAllCommentAuthorizer to only implement what you need.
+type CommentAuthorizer interface {
+	CanCreate(ctx context.Context, input *fm.CommentCreateInput) error
+	CanRead(ctx context.Context) error
+	// CanUpdate gets a nil input when the relationships of the comment are changed
+	CanUpdate(ctx context.Context, input *fm.CommentUpdateInput) error
+	// CanDelete gets the comment before it is deleted, a batch delete asks for every comment it removes
+	CanDelete(ctx context.Context, m *dm.Comment) error
+	// ReadMods limit the comments which can be read e.g. qm.Where("published = ?", true)
+	ReadMods(ctx context.Context) []qm.QueryMod
+}
+
+// AllowAllCommentAuthorizer allows everything, it is used until you pass your own with WithCommentAuthorizer
+type AllowAllCommentAuthorizer struct{}
+
+func (AllowAllCommentAuthorizer) CanCreate(context.Context, *fm.CommentCreateInput) error { return nil }
+func (AllowAllCommentAuthorizer) CanRead(context.Context) error                           { return nil }
+func (AllowAllCommentAuthorizer) CanUpdate(context.Context, *fm.CommentUpdateInput) error { return nil }
+func (AllowAllCommentAuthorizer) CanDelete(context.Context, *dm.Comment) error            { return nil }
+func (AllowAllCommentAuthorizer) ReadMods(context.Context) []qm.QueryMod                  { return nil }
+
+// WithCommentAuthorizer decides who may create, read, update and delete comments
+func WithCommentAuthorizer(authorizer CommentAuthorizer) ResolverOption {
+	return func(r *Resolver) {
+		r.commentAuthorizer = authorizer
+	}
+}
+
+// PostAuthorizer is asked by the generated resolvers before they resolve posts, errors are
+// shown to the client with the FORBIDDEN code. Embed AllowAllPostAuthorizer to only implement what you need.
+type PostAuthorizer interface {
+	CanCreate(ctx context.Context, input *fm.PostCreateInput) error
+	CanRead(ctx context.Context) error
+	// CanUpdate gets a nil input when the relationships of the post are changed
+	CanUpdate(ctx context.Context, input *fm.PostUpdateInput) error
+	// CanDelete gets the post before it is deleted, a batch delete asks for every post it removes
+	CanDelete(ctx context.Context, m *dm.Post) error
+	// ReadMods limit the posts which can be read e.g. qm.Where("published = ?", true)
+	ReadMods(ctx context.Context) []qm.QueryMod
+}
+
+// AllowAllPostAuthorizer allows everything, it is used until you pass your own with WithPostAuthorizer
+type AllowAllPostAuthorizer struct{}
+
+func (AllowAllPostAuthorizer) CanCreate(context.Context, *fm.PostCreateInput) error { return nil }
+func (AllowAllPostAuthorizer) CanRead(context.Context) error                        { return nil }
+func (AllowAllPostAuthorizer) CanUpdate(context.Context, *fm.PostUpdateInput) error { return nil }
+func (AllowAllPostAuthorizer) CanDelete(context.Context, *dm.Post) error            { return nil }
+func (AllowAllPostAuthorizer) ReadMods(context.Context) []qm.QueryMod               { return nil }
+
+// WithPostAuthorizer decides who may create, read, update and delete posts
+func WithPostAuthorizer(authorizer PostAuthorizer) ResolverOption {
+	return func(r *Resolver) {
+		r.postAuthorizer = authorizer
+	}
+}
+
+// PostLikeAuthorizer is asked by the generated resolvers before they resolve postLikes, errors are
+// shown to the client with the FORBIDDEN code. Embed AllowAllPostLikeAuthorizer to only implement what you need.
+type PostLikeAuthorizer interface {
+	CanCreate(ctx context.Context, input *fm.PostLikeCreateInput) error
+	CanRead(ctx context.Context) error
+	// CanUpdate gets a nil input when the relationships of the postLike are changed
+	CanUpdate(ctx context.Context, input *fm.PostLikeUpdateInput) error
+	// CanDelete gets the postLike before it is deleted, a batch delete asks for every postLike it removes
+	CanDelete(ctx context.Context, m *dm.PostLike) error
+	// ReadMods limit the postLikes which can be read e.g. qm.Where("published = ?", true)
+	ReadMods(ctx context.Context) []qm.QueryMod
+}
+
+// AllowAllPostLikeAuthorizer allows everything, it is used until you pass your own with WithPostLikeAuthorizer
+type AllowAllPostLikeAuthorizer struct{}
+
+func (AllowAllPostLikeAuthorizer) CanCreate(context.Context, *fm.PostLikeCreateInput) error {
+	return nil
+}
+func (AllowAllPostLikeAuthorizer) CanRead(context.Context) error { return nil }
+func (AllowAllPostLikeAuthorizer) CanUpdate(context.Context, *fm.PostLikeUpdateInput) error {
+	return nil
+}
+func (AllowAllPostLikeAuthorizer) CanDelete(context.Context, *dm.PostLike) error { return nil }
+func (AllowAllPostLikeAuthorizer) ReadMods(context.Context) []qm.QueryMod        { return nil }
+
+// WithPostLikeAuthorizer decides who may create, read, update and delete postLikes
+func WithPostLikeAuthorizer(authorizer PostLikeAuthorizer) ResolverOption {
+	return func(r *Resolver) {
+		r.postLikeAuthorizer = authorizer
+	}
+}
+
+// SettingAuthorizer is asked by the generated resolvers before they resolve settings, errors are
+// shown to the client with the FORBIDDEN code. Embed AllowAllSettingAuthorizer to only implement what you need.
+type SettingAuthorizer interface {
+	CanCreate(ctx context.Context, input *fm.SettingCreateInput) error
+	CanRead(ctx context.Context) error
+	// CanUpdate gets a nil input when the relationships of the setting are changed
+	CanUpdate(ctx context.Context, input *fm.SettingUpdateInput) error
+	// CanDelete gets the setting before it is deleted, a batch delete asks for every setting it removes
+	CanDelete(ctx context.Context, m *dm.Setting) error
+	// ReadMods limit the settings which can be read e.g. qm.Where("published = ?", true)
+	ReadMods(ctx context.Context) []qm.QueryMod
+}
+
+// AllowAllSettingAuthorizer allows everything, it is used until you pass your own with WithSettingAuthorizer
+type AllowAllSettingAuthorizer struct{}
+
+func (AllowAllSettingAuthorizer) CanCreate(context.Context, *fm.SettingCreateInput) error { return nil }
+func (AllowAllSettingAuthorizer) CanRead(context.Context) error                           { return nil }
+func (AllowAllSettingAuthorizer) CanUpdate(context.Context, *fm.SettingUpdateInput) error { return nil }
+func (AllowAllSettingAuthorizer) CanDelete(context.Context, *dm.Setting) error            { return nil }
+func (AllowAllSettingAuthorizer) ReadMods(context.Context) []qm.QueryMod                  { return nil }
+
+// WithSettingAuthorizer decides who may create, read, update and delete settings
+func WithSettingAuthorizer(authorizer SettingAuthorizer) ResolverOption {
+	return func(r *Resolver) {
+		r.settingAuthorizer = authorizer
+	}
+}
+
+// TagAuthorizer is asked by the generated resolvers before they resolve tags, errors are
+// shown to the client with the FORBIDDEN code. Embed AllowAllTagAuthorizer to only implement what you need.
+type TagAuthorizer interface {
+	CanCreate(ctx context.Context, input *fm.TagCreateInput) error
+	CanRead(ctx context.Context) error
+	CanUpdate(ctx context.Context) error
+	// CanDelete gets the tag before it is deleted, a batch delete asks for every tag it removes
+	CanDelete(ctx context.Context, m *dm.Tag) error
+	// ReadMods limit the tags which can be read e.g. qm.Where("published = ?", true)
+	ReadMods(ctx context.Context) []qm.QueryMod
+}
+
+// AllowAllTagAuthorizer allows everything, it is used until you pass your own with WithTagAuthorizer
+type AllowAllTagAuthorizer struct{}
+
+func (AllowAllTagAuthorizer) CanCreate(context.Context, *fm.TagCreateInput) error { return nil }
+func (AllowAllTagAuthorizer) CanRead(context.Context) error                       { return nil }
+func (AllowAllTagAuthorizer) CanUpdate(context.Context) error                     { return nil }
+func (AllowAllTagAuthorizer) CanDelete(context.Context, *dm.Tag) error            { return nil }
+func (AllowAllTagAuthorizer) ReadMods(context.Context) []qm.QueryMod              { return nil }
+
+// WithTagAuthorizer decides who may create, read, update and delete tags
+func WithTagAuthorizer(authorizer TagAuthorizer) ResolverOption {
+	return func(r *Resolver) {
+		r.tagAuthorizer = authorizer
+	}
+}
+
+const inputKey = "input"
+
+const publicPostLikeCreateError = "could not create postLike"
+
+func (r *mutationResolver) CreatePostLike(ctx context.Context, input fm.PostLikeCreateInput) (*fm.PostLikePayload, error) {
+	if err := r.postLikeAuthorizer.CanCreate(ctx, &input); err != nil {
+		return nil, forbiddenError(err)
+	}
+	if fieldErrors := PostLikeCreateInputValidate(&input); len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicPostLikeCreateError, fieldErrors)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "create", publicPostLikeCreateError, err)
+	}
+
+	m := PostLikeCreateInputToBoiler(&input)
+	if err := r.insertPostLikeCreateInput(ctx, tx, m, &input, boilergql.GetInputFromContext(ctx, inputKey)); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logInputError(ctx, "PostLike", "create", publicPostLikeCreateError, err, PostLikeCreateInputFields)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logInputError(ctx, "PostLike", "create", publicPostLikeCreateError, err, PostLikeCreateInputFields)
+	}
+
+	// resolve requested fields after creating
+	mods := GetPostLikePreloadModsWithLevel(ctx, PostLikePayloadPreloadLevels.PostLike)
+	mods = append(mods, PostLikePrimaryKeyMods(m)...)
+	mods = append(mods, dm.PostLikeWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	pM, err := dm.PostLikes(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "create", publicPostLikeCreateError, err)
+	}
+	return &fm.PostLikePayload{
+		PostLike: PostLikeToGraphQL(pM),
+	}, nil
+}
+
+const publicPostLikeBatchCreateError = "could not create postLikes"
+
+func (r *mutationResolver) CreatePostLikes(ctx context.Context, input []*fm.PostLikeCreateInput) (*fm.PostLikesPayload, error) {
+	for _, row := range input {
+		if err := r.postLikeAuthorizer.CanCreate(ctx, row); err != nil {
+			return nil, forbiddenError(err)
+		}
+	}
+	var fieldErrors []FieldError
+	for i, row := range input {
+		fieldErrors = append(fieldErrors, PrefixFieldErrors(strconv.Itoa(i), PostLikeCreateInputValidate(row))...)
+	}
+	if len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicPostLikeBatchCreateError, fieldErrors)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "batchCreate", publicPostLikeBatchCreateError, err)
+	}
+
+	rawInputs := GetInputsFromContext(ctx, inputKey)
+	created := make([]*dm.PostLike, len(input))
+	for i, row := range input {
+		m := PostLikeCreateInputToBoiler(row)
+		if err := r.insertPostLikeCreateInput(ctx, tx, m, row, rawInputs[i]); err != nil {
+			_ = tx.Rollback()
+			return nil, r.logNestedInputError(ctx, "PostLike", "batchCreate", publicPostLikeBatchCreateError, err,
+				strconv.Itoa(i), PostLikeCreateInputFields)
+		}
+		created[i] = m
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "PostLike", "batchCreate", publicPostLikeBatchCreateError, err)
+	}
+
+	// resolve requested fields after creating
+	mods := GetPostLikePreloadModsWithLevel(ctx, PostLikesPayloadPreloadLevels.PostLikes)
+	mods = append(mods, PostLikesPrimaryKeyMod(created))
+	mods = append(mods, dm.PostLikeWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	a, err := dm.PostLikes(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "batchCreate", publicPostLikeBatchCreateError, err)
+	}
+	return &fm.PostLikesPayload{
+		PostLikes: PostLikesToGraphQL(a),
+	}, nil
+}
+
+const publicPostLikeUpdateError = "could not update postLike"
+
+func (r *mutationResolver) UpdatePostLike(ctx context.Context, id string, input fm.PostLikeUpdateInput) (*fm.PostLikePayload, error) {
+	if err := r.postLikeAuthorizer.CanUpdate(ctx, &input); err != nil {
+		return nil, forbiddenError(err)
+	}
+	if fieldErrors := PostLikeUpdateInputValidate(&input); len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicPostLikeUpdateError, fieldErrors)
+	}
+	dbID, err := PostLikeID(id)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "update", publicPostLikeUpdateError, err)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "update", publicPostLikeUpdateError, err)
+	}
+
+	m := PostLikeUpdateInputToModelM(boilergql.GetInputFromContext(ctx, inputKey), input)
+
+	if _, err := dm.PostLikes(
+		qm.Expr(PostLikePrimaryKeyMods(dbID)...),
+		dm.PostLikeWhere.UserID.EQ(auth.UserIDFromContext(ctx)),
+	).UpdateAll(ctx, tx, m); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logInputError(ctx, "PostLike", "update", publicPostLikeUpdateError, err, PostLikeUpdateInputFields)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logInputError(ctx, "PostLike", "update", publicPostLikeUpdateError, err, PostLikeUpdateInputFields)
+	}
+
+	// resolve requested fields after updating
+	mods := GetPostLikePreloadModsWithLevel(ctx, PostLikePayloadPreloadLevels.PostLike)
+	mods = append(mods, qm.Expr(PostLikePrimaryKeyMods(dbID)...))
+	mods = append(mods, dm.PostLikeWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	pM, err := dm.PostLikes(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "update", publicPostLikeUpdateError, err)
+	}
+	return &fm.PostLikePayload{
+		PostLike: PostLikeToGraphQL(pM),
+	}, nil
+}
+
+const publicPostLikeDeleteError = "could not delete postLike"
+
+func (r *mutationResolver) DeletePostLike(ctx context.Context, id string) (*fm.PostLikeDeletePayload, error) {
+	dbID, err := PostLikeID(id)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "delete", publicPostLikeDeleteError, err)
+	}
+
+	mods := []qm.QueryMod{
+		qm.Expr(PostLikePrimaryKeyMods(dbID)...),
+		dm.PostLikeWhere.UserID.EQ(auth.UserIDFromContext(ctx)),
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "delete", publicPostLikeDeleteError, err)
+	}
+	m, err := dm.PostLikes(mods...).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "PostLike", "delete", publicPostLikeDeleteError, err)
+	}
+	if err := r.postLikeAuthorizer.CanDelete(ctx, m); err != nil {
+		_ = tx.Rollback()
+		return nil, forbiddenError(err)
+	}
+	// the row which is checked is deleted, not every row which matches the query by then
+	if _, err := m.Delete(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "PostLike", "delete", publicPostLikeDeleteError, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "PostLike", "delete", publicPostLikeDeleteError, err)
+	}
+
+	return &fm.PostLikeDeletePayload{
+		ID: id,
+	}, nil
+}
+
+const publicPostLikeBatchDeleteError = "could not delete postLikes"
+
+func (r *mutationResolver) DeletePostLikes(ctx context.Context, filter *fm.PostLikeFilter) (*fm.PostLikesDeletePayload, error) {
+	var mods []qm.QueryMod
+	mods = append(mods, dm.PostLikeWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	mods = append(mods, PostLikeFilterToMods(filter)...)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "batchDelete", publicPostLikeBatchDeleteError, err)
+	}
+	toRemove, err := dm.PostLikes(mods...).All(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "PostLike", "batchDelete", publicPostLikeBatchDeleteError, err)
+	}
+	for _, m := range toRemove {
+		if err := r.postLikeAuthorizer.CanDelete(ctx, m); err != nil {
+			_ = tx.Rollback()
+			return nil, forbiddenError(err)
+		}
+	}
+	mods = []qm.QueryMod{PostLikesPrimaryKeyMod(toRemove)}
+	if _, err := dm.PostLikes(mods...).DeleteAll(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "PostLike", "batchDelete", publicPostLikeBatchDeleteError, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "PostLike", "batchDelete", publicPostLikeBatchDeleteError, err)
+	}
+	ids := make([]string, len(toRemove))
+	for i, m := range toRemove {
+		ids[i] = PostLikeIDToGraphQL(m)
+	}
+	return &fm.PostLikesDeletePayload{
+		Ids: ids,
+	}, nil
+}
+
+const publicSettingCreateError = "could not create setting"
+
+func (r *mutationResolver) CreateSetting(ctx context.Context, input fm.SettingCreateInput) (*fm.SettingPayload, error) {
+	if err := r.settingAuthorizer.CanCreate(ctx, &input); err != nil {
+		return nil, forbiddenError(err)
+	}
+	if fieldErrors := SettingCreateInputValidate(&input); len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicSettingCreateError, fieldErrors)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Setting", "create", publicSettingCreateError, err)
+	}
+
+	m := SettingCreateInputToBoiler(&input)
+	if err := r.insertSettingCreateInput(ctx, tx, m, &input, boilergql.GetInputFromContext(ctx, inputKey)); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logInputError(ctx, "Setting", "create", publicSettingCreateError, err, SettingCreateInputFields)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logInputError(ctx, "Setting", "create", publicSettingCreateError, err, SettingCreateInputFields)
+	}
+
+	// resolve requested fields after creating
+	mods := GetSettingPreloadModsWithLevel(ctx, SettingPayloadPreloadLevels.Setting)
+	mods = append(mods, dm.SettingWhere.Key.EQ(m.Key))
+	pM, err := dm.Settings(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Setting", "create", publicSettingCreateError, err)
+	}
+	return &fm.SettingPayload{
+		Setting: SettingToGraphQL(pM),
+	}, nil
+}
+
+const publicSettingUpdateError = "could not update setting"
+
+func (r *mutationResolver) UpdateSetting(ctx context.Context, id string, input fm.SettingUpdateInput) (*fm.SettingPayload, error) {
+	if err := r.settingAuthorizer.CanUpdate(ctx, &input); err != nil {
+		return nil, forbiddenError(err)
+	}
+	if fieldErrors := SettingUpdateInputValidate(&input); len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicSettingUpdateError, fieldErrors)
+	}
+	dbID := id
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Setting", "update", publicSettingUpdateError, err)
+	}
+
+	m := SettingUpdateInputToModelM(boilergql.GetInputFromContext(ctx, inputKey), input)
+
+	if _, err := dm.Settings(
+		dm.SettingWhere.Key.EQ(dbID),
+	).UpdateAll(ctx, tx, m); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logInputError(ctx, "Setting", "update", publicSettingUpdateError, err, SettingUpdateInputFields)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logInputError(ctx, "Setting", "update", publicSettingUpdateError, err, SettingUpdateInputFields)
+	}
+
+	// resolve requested fields after updating
+	mods := GetSettingPreloadModsWithLevel(ctx, SettingPayloadPreloadLevels.Setting)
+	mods = append(mods, dm.SettingWhere.Key.EQ(dbID))
+	pM, err := dm.Settings(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Setting", "update", publicSettingUpdateError, err)
+	}
+	return &fm.SettingPayload{
+		Setting: SettingToGraphQL(pM),
+	}, nil
+}
+
+const publicSettingDeleteError = "could not delete setting"
+
+func (r *mutationResolver) DeleteSetting(ctx context.Context, id string) (*fm.SettingDeletePayload, error) {
+	dbID := id
+
+	mods := []qm.QueryMod{
+		dm.SettingWhere.Key.EQ(dbID),
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Setting", "delete", publicSettingDeleteError, err)
+	}
+	m, err := dm.Settings(mods...).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Setting", "delete", publicSettingDeleteError, err)
+	}
+	if err := r.settingAuthorizer.CanDelete(ctx, m); err != nil {
+		_ = tx.Rollback()
+		return nil, forbiddenError(err)
+	}
+	// the row which is checked is deleted, not every row which matches the query by then
+	if _, err := m.Delete(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Setting", "delete", publicSettingDeleteError, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "Setting", "delete", publicSettingDeleteError, err)
+	}
+
+	return &fm.SettingDeletePayload{
+		ID: id,
+	}, nil
+}
+
+const publicPostCreateError = "could not create post"
+
+func (r *mutationResolver) CreatePost(ctx context.Context, input fm.PostCreateInput) (*fm.PostPayload, error) {
+	if err := r.postAuthorizer.CanCreate(ctx, &input); err != nil {
+		return nil, forbiddenError(err)
+	}
+	if fieldErrors := PostCreateInputValidate(&input); len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicPostCreateError, fieldErrors)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "create", publicPostCreateError, err)
+	}
+
+	m := PostCreateInputToBoiler(&input)
+	if err := r.insertPostCreateInput(ctx, tx, m, &input, boilergql.GetInputFromContext(ctx, inputKey)); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logInputError(ctx, "Post", "create", publicPostCreateError, err, PostCreateInputFields)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logInputError(ctx, "Post", "create", publicPostCreateError, err, PostCreateInputFields)
+	}
+
+	// resolve requested fields after creating
+	mods := GetPostPreloadModsWithLevel(ctx, PostPayloadPreloadLevels.Post)
+	mods = append(mods, dm.PostWhere.ID.EQ(m.ID))
+	mods = append(mods, dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)))
+	mods = append(mods, dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	pM, err := dm.Posts(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "create", publicPostCreateError, err)
+	}
+	return &fm.PostPayload{
+		Post: PostToGraphQL(pM),
+	}, nil
+}
+
+const publicPostBatchCreateError = "could not create posts"
+
+func (r *mutationResolver) CreatePosts(ctx context.Context, input []*fm.PostCreateInput) (*fm.PostsPayload, error) {
+	for _, row := range input {
+		if err := r.postAuthorizer.CanCreate(ctx, row); err != nil {
+			return nil, forbiddenError(err)
+		}
+	}
+	var fieldErrors []FieldError
+	for i, row := range input {
+		fieldErrors = append(fieldErrors, PrefixFieldErrors(strconv.Itoa(i), PostCreateInputValidate(row))...)
+	}
+	if len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicPostBatchCreateError, fieldErrors)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "batchCreate", publicPostBatchCreateError, err)
+	}
+
+	rawInputs := GetInputsFromContext(ctx, inputKey)
+	ids := make([]uint, len(input))
+	for i, row := range input {
+		m := PostCreateInputToBoiler(row)
+		if err := r.insertPostCreateInput(ctx, tx, m, row, rawInputs[i]); err != nil {
+			_ = tx.Rollback()
+			return nil, r.logNestedInputError(ctx, "Post", "batchCreate", publicPostBatchCreateError, err,
+				strconv.Itoa(i), PostCreateInputFields)
+		}
+		ids[i] = m.ID
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "Post", "batchCreate", publicPostBatchCreateError, err)
+	}
+
+	// resolve requested fields after creating
+	mods := GetPostPreloadModsWithLevel(ctx, PostsPayloadPreloadLevels.Posts)
+	mods = append(mods, dm.PostWhere.ID.IN(ids))
+	mods = append(mods, dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)))
+	mods = append(mods, dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	a, err := dm.Posts(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "batchCreate", publicPostBatchCreateError, err)
+	}
+	return &fm.PostsPayload{
+		Posts: PostsToGraphQL(a),
+	}, nil
+}
+
+const publicPostUpdateError = "could not update post"
+
+func (r *mutationResolver) UpdatePost(ctx context.Context, id string, input fm.PostUpdateInput) (*fm.PostPayload, error) {
+	if err := r.postAuthorizer.CanUpdate(ctx, &input); err != nil {
+		return nil, forbiddenError(err)
+	}
+	if fieldErrors := PostUpdateInputValidate(&input); len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicPostUpdateError, fieldErrors)
+	}
+	dbID, err := PostID(id)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "update", publicPostUpdateError, err)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "update", publicPostUpdateError, err)
+	}
+
+	m := PostUpdateInputToModelM(boilergql.GetInputFromContext(ctx, inputKey), input)
+
+	if _, err := dm.Posts(
+		dm.PostWhere.ID.EQ(dbID),
+		dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)),
+		dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)),
+	).UpdateAll(ctx, tx, m); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logInputError(ctx, "Post", "update", publicPostUpdateError, err, PostUpdateInputFields)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logInputError(ctx, "Post", "update", publicPostUpdateError, err, PostUpdateInputFields)
+	}
+
+	// resolve requested fields after updating
+	mods := GetPostPreloadModsWithLevel(ctx, PostPayloadPreloadLevels.Post)
+	mods = append(mods, dm.PostWhere.ID.EQ(dbID))
+	mods = append(mods, dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)))
+	mods = append(mods, dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	pM, err := dm.Posts(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "update", publicPostUpdateError, err)
+	}
+	return &fm.PostPayload{
+		Post: PostToGraphQL(pM),
+	}, nil
+}
+
+const publicPostBatchUpdateError = "could not update posts"
+
+func (r *mutationResolver) UpdatePosts(ctx context.Context, filter *fm.PostFilter, input fm.PostUpdateInput) (*fm.PostsUpdatePayload, error) {
+	if err := r.postAuthorizer.CanUpdate(ctx, &input); err != nil {
+		return nil, forbiddenError(err)
+	}
+	if fieldErrors := PostUpdateInputValidate(&input); len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicPostBatchUpdateError, fieldErrors)
+	}
+	var mods []qm.QueryMod
+	mods = append(mods, dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)))
+	mods = append(mods, dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	mods = append(mods, PostFilterToMods(filter)...)
+
+	m := PostUpdateInputToModelM(boilergql.GetInputFromContext(ctx, inputKey), input)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "batchUpdate", publicPostBatchUpdateError, err)
+	}
+	if _, err := dm.Posts(mods...).UpdateAll(ctx, tx, m); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logInputError(ctx, "Post", "batchUpdate", publicPostBatchUpdateError, err, PostUpdateInputFields)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logInputError(ctx, "Post", "batchUpdate", publicPostBatchUpdateError, err, PostUpdateInputFields)
+	}
+
+	return &fm.PostsUpdatePayload{
+		Ok: true,
+	}, nil
+}
+
+const publicPostDeleteError = "could not delete post"
+
+func (r *mutationResolver) DeletePost(ctx context.Context, id string, hardDelete *bool) (*fm.PostDeletePayload, error) {
+	dbID, err := PostID(id)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "delete", publicPostDeleteError, err)
+	}
+
+	mods := []qm.QueryMod{
+		dm.PostWhere.ID.EQ(dbID),
+		dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)),
+		dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)),
+	}
+	if hardDelete != nil && *hardDelete {
+		// rows which are already soft deleted can be removed too
+		mods = append(mods, qm.WithDeleted())
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "delete", publicPostDeleteError, err)
+	}
+	m, err := dm.Posts(mods...).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Post", "delete", publicPostDeleteError, err)
+	}
+	if err := r.postAuthorizer.CanDelete(ctx, m); err != nil {
+		_ = tx.Rollback()
+		return nil, forbiddenError(err)
+	}
+	// the row which is checked is deleted, not every row which matches the query by then
+	if _, err := m.Delete(ctx, tx, hardDelete != nil && *hardDelete); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Post", "delete", publicPostDeleteError, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "Post", "delete", publicPostDeleteError, err)
+	}
+
+	return &fm.PostDeletePayload{
+		ID: id,
+	}, nil
+}
+
+const publicPostBatchDeleteError = "could not delete posts"
+
+func (r *mutationResolver) DeletePosts(ctx context.Context, filter *fm.PostFilter) (*fm.PostsDeletePayload, error) {
+	var mods []qm.QueryMod
+	mods = append(mods, dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)))
+	mods = append(mods, dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	mods = append(mods, PostFilterToMods(filter)...)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "batchDelete", publicPostBatchDeleteError, err)
+	}
+	toRemove, err := dm.Posts(mods...).All(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Post", "batchDelete", publicPostBatchDeleteError, err)
+	}
+	for _, m := range toRemove {
+		if err := r.postAuthorizer.CanDelete(ctx, m); err != nil {
+			_ = tx.Rollback()
+			return nil, forbiddenError(err)
+		}
+	}
+	boilerIDs := make([]uint, len(toRemove))
+	for i, m := range toRemove {
+		boilerIDs[i] = m.ID
+	}
+	mods = []qm.QueryMod{dm.PostWhere.ID.IN(boilerIDs)}
+	if _, err := dm.Posts(mods...).DeleteAll(ctx, tx, false); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Post", "batchDelete", publicPostBatchDeleteError, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "Post", "batchDelete", publicPostBatchDeleteError, err)
+	}
+	return &fm.PostsDeletePayload{
+		Ids: boilergql.UintIDsToGraphQL(boilerIDs, dm.TableNames.Posts),
+	}, nil
+}
+
+const publicCommentCreateError = "could not create comment"
+
+func (r *mutationResolver) CreateComment(ctx context.Context, input fm.CommentCreateInput) (*fm.CommentPayload, error) {
+	if err := r.commentAuthorizer.CanCreate(ctx, &input); err != nil {
+		return nil, forbiddenError(err)
+	}
+	if fieldErrors := CommentCreateInputValidate(&input); len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicCommentCreateError, fieldErrors)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "create", publicCommentCreateError, err)
+	}
+
+	m := CommentCreateInputToBoiler(&input)
+	if err := r.insertCommentCreateInput(ctx, tx, m, &input, boilergql.GetInputFromContext(ctx, inputKey)); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logInputError(ctx, "Comment", "create", publicCommentCreateError, err, CommentCreateInputFields)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logInputError(ctx, "Comment", "create", publicCommentCreateError, err, CommentCreateInputFields)
+	}
+
+	// resolve requested fields after creating
+	mods := GetCommentPreloadModsWithLevel(ctx, CommentPayloadPreloadLevels.Comment)
+	mods = append(mods, dm.CommentWhere.ID.EQ(m.ID))
+	mods = append(mods, dm.CommentWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	pM, err := dm.Comments(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "create", publicCommentCreateError, err)
+	}
+	return &fm.CommentPayload{
+		Comment: CommentToGraphQL(pM),
+	}, nil
+}
+
+const publicCommentUpdateError = "could not update comment"
+
+func (r *mutationResolver) UpdateComment(ctx context.Context, id string, input fm.CommentUpdateInput) (*fm.CommentPayload, error) {
+	if err := r.commentAuthorizer.CanUpdate(ctx, &input); err != nil {
+		return nil, forbiddenError(err)
+	}
+	if fieldErrors := CommentUpdateInputValidate(&input); len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicCommentUpdateError, fieldErrors)
+	}
+	dbID, err := CommentID(id)
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "update", publicCommentUpdateError, err)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "update", publicCommentUpdateError, err)
+	}
+
+	m := CommentUpdateInputToModelM(boilergql.GetInputFromContext(ctx, inputKey), input)
+
+	if _, err := dm.Comments(
+		dm.CommentWhere.ID.EQ(dbID),
+		dm.CommentWhere.UserID.EQ(auth.UserIDFromContext(ctx)),
+	).UpdateAll(ctx, tx, m); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logInputError(ctx, "Comment", "update", publicCommentUpdateError, err, CommentUpdateInputFields)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logInputError(ctx, "Comment", "update", publicCommentUpdateError, err, CommentUpdateInputFields)
+	}
+
+	// resolve requested fields after updating
+	mods := GetCommentPreloadModsWithLevel(ctx, CommentPayloadPreloadLevels.Comment)
+	mods = append(mods, dm.CommentWhere.ID.EQ(dbID))
+	mods = append(mods, dm.CommentWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	pM, err := dm.Comments(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "update", publicCommentUpdateError, err)
+	}
+	return &fm.CommentPayload{
+		Comment: CommentToGraphQL(pM),
+	}, nil
+}
+
+const publicCommentDeleteError = "could not delete comment"
+
+func (r *mutationResolver) DeleteComment(ctx context.Context, id string) (*fm.CommentDeletePayload, error) {
+	dbID, err := CommentID(id)
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "delete", publicCommentDeleteError, err)
+	}
+
+	mods := []qm.QueryMod{
+		dm.CommentWhere.ID.EQ(dbID),
+		dm.CommentWhere.UserID.EQ(auth.UserIDFromContext(ctx)),
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "delete", publicCommentDeleteError, err)
+	}
+	m, err := dm.Comments(mods...).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Comment", "delete", publicCommentDeleteError, err)
+	}
+	if err := r.commentAuthorizer.CanDelete(ctx, m); err != nil {
+		_ = tx.Rollback()
+		return nil, forbiddenError(err)
+	}
+	// the row which is checked is deleted, not every row which matches the query by then
+	if _, err := m.Delete(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Comment", "delete", publicCommentDeleteError, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "Comment", "delete", publicCommentDeleteError, err)
+	}
+
+	return &fm.CommentDeletePayload{
+		ID: id,
+	}, nil
+}
+
+const publicTagCreateError = "could not create tag"
+
+func (r *mutationResolver) CreateTag(ctx context.Context, input fm.TagCreateInput) (*fm.TagPayload, error) {
+	if err := r.tagAuthorizer.CanCreate(ctx, &input); err != nil {
+		return nil, forbiddenError(err)
+	}
+	if fieldErrors := TagCreateInputValidate(&input); len(fieldErrors) > 0 {
+		return nil, NewValidationError(publicTagCreateError, fieldErrors)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Tag", "create", publicTagCreateError, err)
+	}
+
+	m := TagCreateInputToBoiler(&input)
+	if err := r.insertTagCreateInput(ctx, tx, m, &input, boilergql.GetInputFromContext(ctx, inputKey)); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logInputError(ctx, "Tag", "create", publicTagCreateError, err, TagCreateInputFields)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logInputError(ctx, "Tag", "create", publicTagCreateError, err, TagCreateInputFields)
+	}
+
+	// resolve requested fields after creating
+	mods := GetTagPreloadModsWithLevel(ctx, TagPayloadPreloadLevels.Tag)
+	mods = append(mods, dm.TagWhere.ID.EQ(m.ID))
+	pM, err := dm.Tags(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Tag", "create", publicTagCreateError, err)
+	}
+	return &fm.TagPayload{
+		Tag: TagToGraphQL(pM),
+	}, nil
+}
+
+const publicPostAddTagsError = "could not add tags to post"
+
+func (r *mutationResolver) AddPostTags(ctx context.Context, postID string, tagIds []string) (*fm.PostPayload, error) {
+	if err := r.postAuthorizer.CanUpdate(ctx, nil); err != nil {
+		return nil, forbiddenError(err)
+	}
+	dbID, err := PostID(postID)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "addTags", publicPostAddTagsError, err)
+	}
+
+	m, err := dm.Posts(
+		dm.PostWhere.ID.EQ(dbID),
+		dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)),
+		dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)),
+	).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "addTags", publicPostAddTagsError, err)
+	}
+
+	// only relate rows the user has access to, an id which is given twice is only found once
+	tagIds = UniqueIDs(tagIds)
+	relatedIDs, err := TagIDs(tagIds)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "addTags", publicPostAddTagsError, err)
+	}
+	relatedMods := []qm.QueryMod{
+		dm.TagWhere.ID.IN(relatedIDs),
+	}
+	relatedMods = append(relatedMods, r.tagAuthorizer.ReadMods(ctx)...)
+	related, err := dm.Tags(relatedMods...).All(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "addTags", publicPostAddTagsError, err)
+	}
+	if len(related) != len(tagIds) {
+		err := fmt.Errorf("not all tags are found: %w", sql.ErrNoRows)
+		return nil, r.logError(ctx, "Post", "addTags", publicPostAddTagsError, err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "addTags", publicPostAddTagsError, err)
+	}
+	if err := m.AddTags(ctx, tx, false, related...); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Post", "addTags", publicPostAddTagsError, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "Post", "addTags", publicPostAddTagsError, err)
+	}
+
+	// resolve requested fields after changing the relationship
+	mods := GetPostPreloadModsWithLevel(ctx, PostPayloadPreloadLevels.Post)
+	mods = append(mods, dm.PostWhere.ID.EQ(dbID))
+	pM, err := dm.Posts(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "addTags", publicPostAddTagsError, err)
+	}
+	return &fm.PostPayload{
+		Post: PostToGraphQL(pM),
+	}, nil
+}
+
+const publicPostRemoveTagsError = "could not remove tags from post"
+
+func (r *mutationResolver) RemovePostTags(ctx context.Context, postID string, tagIds []string) (*fm.PostPayload, error) {
+	if err := r.postAuthorizer.CanUpdate(ctx, nil); err != nil {
+		return nil, forbiddenError(err)
+	}
+	dbID, err := PostID(postID)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "removeTags", publicPostRemoveTagsError, err)
+	}
+
+	m, err := dm.Posts(
+		dm.PostWhere.ID.EQ(dbID),
+		dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)),
+		dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)),
+	).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "removeTags", publicPostRemoveTagsError, err)
+	}
+
+	// only relate rows the user has access to, an id which is given twice is only found once
+	tagIds = UniqueIDs(tagIds)
+	relatedIDs, err := TagIDs(tagIds)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "removeTags", publicPostRemoveTagsError, err)
+	}
+	relatedMods := []qm.QueryMod{
+		dm.TagWhere.ID.IN(relatedIDs),
+	}
+	relatedMods = append(relatedMods, r.tagAuthorizer.ReadMods(ctx)...)
+	related, err := dm.Tags(relatedMods...).All(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "removeTags", publicPostRemoveTagsError, err)
+	}
+	if len(related) != len(tagIds) {
+		err := fmt.Errorf("not all tags are found: %w", sql.ErrNoRows)
+		return nil, r.logError(ctx, "Post", "removeTags", publicPostRemoveTagsError, err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "removeTags", publicPostRemoveTagsError, err)
+	}
+	if err := m.RemoveTags(ctx, tx, related...); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Post", "removeTags", publicPostRemoveTagsError, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "Post", "removeTags", publicPostRemoveTagsError, err)
+	}
+
+	// resolve requested fields after changing the relationship
+	mods := GetPostPreloadModsWithLevel(ctx, PostPayloadPreloadLevels.Post)
+	mods = append(mods, dm.PostWhere.ID.EQ(dbID))
+	pM, err := dm.Posts(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "removeTags", publicPostRemoveTagsError, err)
+	}
+	return &fm.PostPayload{
+		Post: PostToGraphQL(pM),
+	}, nil
+}
+
+const publicPostSetTagsError = "could not set tags of post"
+
+func (r *mutationResolver) SetPostTags(ctx context.Context, postID string, tagIds []string) (*fm.PostPayload, error) {
+	if err := r.postAuthorizer.CanUpdate(ctx, nil); err != nil {
+		return nil, forbiddenError(err)
+	}
+	dbID, err := PostID(postID)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "setTags", publicPostSetTagsError, err)
+	}
+
+	m, err := dm.Posts(
+		dm.PostWhere.ID.EQ(dbID),
+		dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)),
+		dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)),
+	).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "setTags", publicPostSetTagsError, err)
+	}
+
+	// only relate rows the user has access to, an id which is given twice is only found once
+	tagIds = UniqueIDs(tagIds)
+	relatedIDs, err := TagIDs(tagIds)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "setTags", publicPostSetTagsError, err)
+	}
+	relatedMods := []qm.QueryMod{
+		dm.TagWhere.ID.IN(relatedIDs),
+	}
+	relatedMods = append(relatedMods, r.tagAuthorizer.ReadMods(ctx)...)
+	related, err := dm.Tags(relatedMods...).All(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "setTags", publicPostSetTagsError, err)
+	}
+	if len(related) != len(tagIds) {
+		err := fmt.Errorf("not all tags are found: %w", sql.ErrNoRows)
+		return nil, r.logError(ctx, "Post", "setTags", publicPostSetTagsError, err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "setTags", publicPostSetTagsError, err)
+	}
+	if err := m.SetTags(ctx, tx, false, related...); err != nil {
+		_ = tx.Rollback()
+		return nil, r.logError(ctx, "Post", "setTags", publicPostSetTagsError, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, r.logError(ctx, "Post", "setTags", publicPostSetTagsError, err)
+	}
+
+	// resolve requested fields after changing the relationship
+	mods := GetPostPreloadModsWithLevel(ctx, PostPayloadPreloadLevels.Post)
+	mods = append(mods, dm.PostWhere.ID.EQ(dbID))
+	pM, err := dm.Posts(mods...).One(ctx, r.db)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "setTags", publicPostSetTagsError, err)
+	}
+	return &fm.PostPayload{
+		Post: PostToGraphQL(pM),
+	}, nil
+}
+
+const publicPostLikeSingleError = "could not get postLike"
+
+func (r *queryResolver) PostLike(ctx context.Context, id string) (*fm.PostLike, error) {
+	if err := r.postLikeAuthorizer.CanRead(ctx); err != nil {
+		return nil, forbiddenError(err)
+	}
+	dbID, err := PostLikeID(id)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "single", publicPostLikeSingleError, err)
+	}
+
+	mods := GetPostLikePreloadMods(ctx)
+	mods = append(mods, qm.Expr(PostLikePrimaryKeyMods(dbID)...))
+	mods = append(mods, dm.PostLikeWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	mods = append(mods, r.postLikeAuthorizer.ReadMods(ctx)...)
+	m, err := dm.PostLikes(mods...).One(ctx, r.readDB(ctx))
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "single", publicPostLikeSingleError, err)
+	}
+	return PostLikeToGraphQL(m), nil
+}
+
+const publicPostLikeConnectionError = "could not list postLikes"
+
+func (r *queryResolver) PostLikes(ctx context.Context, first *int, after *string, last *int, before *string, filter *fm.PostLikeFilter) (*fm.PostLikeConnection, error) {
+	if err := r.postLikeAuthorizer.CanRead(ctx); err != nil {
+		return nil, forbiddenError(err)
+	}
+	columns := PostLikeDefaultCursorColumns
+	pagination := ConnectionPagination{
+		First:  first,
+		After:  after,
+		Last:   last,
+		Before: before,
+	}
+
+	mods := GetPostLikePreloadModsWithLevel(ctx, EdgesNodePreloadLevel)
+	mods = append(mods, dm.PostLikeWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	mods = append(mods, r.postLikeAuthorizer.ReadMods(ctx)...)
+
+	mods = append(mods, PostLikeFilterToMods(filter)...)
+	paginationMods, err := pagination.Mods(columns, PostLikeCursorValue)
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "connection", publicPostLikeConnectionError, err)
+	}
+	mods = append(mods, paginationMods...)
+	a, err := dm.PostLikes(mods...).All(ctx, r.readDB(ctx))
+	if err != nil {
+		return nil, r.logError(ctx, "PostLike", "connection", publicPostLikeConnectionError, err)
+	}
+	return PostLikesToPostLikeConnection(a, columns, pagination), nil
+}
+
+const publicPostSingleError = "could not get post"
+
+func (r *queryResolver) Post(ctx context.Context, id string, withDeleted *bool) (*fm.Post, error) {
+	if err := r.postAuthorizer.CanRead(ctx); err != nil {
+		return nil, forbiddenError(err)
+	}
+	dbID, err := PostID(id)
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "single", publicPostSingleError, err)
+	}
+
+	mods := GetPostPreloadMods(ctx)
+	mods = append(mods, dm.PostWhere.ID.EQ(dbID))
+	mods = append(mods, dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)))
+	mods = append(mods, dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	mods = append(mods, r.postAuthorizer.ReadMods(ctx)...)
+	if withDeleted != nil && *withDeleted {
+		mods = append(mods, qm.WithDeleted())
+	}
+	m, err := dm.Posts(mods...).One(ctx, r.readDB(ctx))
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "single", publicPostSingleError, err)
+	}
+	return PostToGraphQL(m), nil
+}
+
+const publicPostListError = "could not list posts"
+
+func (r *queryResolver) Posts(ctx context.Context, filter *fm.PostFilter, orderBy []*fm.PostOrderBy, withDeleted bool) ([]*fm.Post, error) {
+	if err := r.postAuthorizer.CanRead(ctx); err != nil {
+		return nil, forbiddenError(err)
+	}
+	mods := GetPostPreloadMods(ctx)
+	mods = append(mods, dm.PostWhere.OrganizationID.EQ(auth.OrganizationIDFromContext(ctx)))
+	mods = append(mods, dm.PostWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	mods = append(mods, r.postAuthorizer.ReadMods(ctx)...)
+
+	mods = append(mods, PostFilterToMods(filter)...)
+	mods = append(mods, PostOrderByToMods(orderBy)...)
+	if withDeleted {
+		mods = append(mods, qm.WithDeleted())
+	}
+	a, err := dm.Posts(mods...).All(ctx, r.readDB(ctx))
+	if err != nil {
+		return nil, r.logError(ctx, "Post", "list", publicPostListError, err)
+	}
+	return PostsToGraphQL(a), nil
+}
+
+const publicCommentSingleError = "could not get comment"
+
+func (r *queryResolver) Comment(ctx context.Context, id string) (*fm.Comment, error) {
+	if err := r.commentAuthorizer.CanRead(ctx); err != nil {
+		return nil, forbiddenError(err)
+	}
+	dbID, err := CommentID(id)
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "single", publicCommentSingleError, err)
+	}
+
+	mods := GetCommentPreloadMods(ctx)
+	mods = append(mods, dm.CommentWhere.ID.EQ(dbID))
+	mods = append(mods, dm.CommentWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	mods = append(mods, r.commentAuthorizer.ReadMods(ctx)...)
+	m, err := dm.Comments(mods...).One(ctx, r.readDB(ctx))
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "single", publicCommentSingleError, err)
+	}
+	return CommentToGraphQL(m), nil
+}
+
+const publicCommentConnectionError = "could not list comments"
+
+func (r *queryResolver) Comments(ctx context.Context, first *int, after *string, last *int, before *string, filter *fm.CommentFilter, orderBy []*fm.CommentOrderBy) (*fm.CommentConnection, error) {
+	if err := r.commentAuthorizer.CanRead(ctx); err != nil {
+		return nil, forbiddenError(err)
+	}
+	columns := append(CommentOrderByToSortColumns(orderBy), CommentDefaultCursorColumns...)
+	pagination := ConnectionPagination{
+		First:  first,
+		After:  after,
+		Last:   last,
+		Before: before,
+	}
+
+	mods := GetCommentPreloadModsWithLevel(ctx, EdgesNodePreloadLevel)
+	mods = append(mods, dm.CommentWhere.UserID.EQ(auth.UserIDFromContext(ctx)))
+	mods = append(mods, r.commentAuthorizer.ReadMods(ctx)...)
+
+	mods = append(mods, CommentFilterToMods(filter)...)
+	paginationMods, err := pagination.Mods(columns, CommentCursorValue)
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "connection", publicCommentConnectionError, err)
+	}
+	mods = append(mods, paginationMods...)
+	a, err := dm.Comments(mods...).All(ctx, r.readDB(ctx))
+	if err != nil {
+		return nil, r.logError(ctx, "Comment", "connection", publicCommentConnectionError, err)
+	}
+	return CommentsToCommentConnection(a, columns, pagination), nil
+}
+
+// insertCommentCreateInput inserts the comment together with the relations which are nested in the input, the
+// authorizers of the nested relations are asked before they are inserted
+func (r *Resolver) insertCommentCreateInput(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	m *dm.Comment,
+	input *fm.CommentCreateInput,
+	rawInput map[string]interface{},
+	extraColumns ...string,
+) error {
+	if input.User != nil {
+		// UserID is set by the scope so the user would not be used
+		return NewPublicError(ErrorCodeValidation, "user can not be created here")
+	}
+	m.UserID = auth.UserIDFromContext(ctx)
+	extraColumns = append(extraColumns, dm.CommentColumns.UserID)
+	whiteList := CommentCreateInputToBoilerWhitelist(rawInput, extraColumns...)
+	if err := m.Insert(ctx, exec, whiteList); err != nil {
+		return err
+	}
+	return nil
+}
+
+// insertPostCreateInput inserts the post together with the relations which are nested in the input, the
+// authorizers of the nested relations are asked before they are inserted
+func (r *Resolver) insertPostCreateInput(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	m *dm.Post,
+	input *fm.PostCreateInput,
+	rawInput map[string]interface{},
+	extraColumns ...string,
+) error {
+	m.OrganizationID = auth.OrganizationIDFromContext(ctx)
+	m.UserID = auth.UserIDFromContext(ctx)
+	extraColumns = append(extraColumns, dm.PostColumns.OrganizationID)
+	extraColumns = append(extraColumns, dm.PostColumns.UserID)
+	whiteList := PostCreateInputToBoilerWhitelist(rawInput, extraColumns...)
+	if err := m.Insert(ctx, exec, whiteList); err != nil {
+		return err
+	}
+	rawChildrenComments := GetNestedInputs(rawInput, "comments")
+	for i, childInput := range input.Comments {
+		if childInput == nil {
+			continue
+		}
+		if err := r.commentAuthorizer.CanCreate(ctx, childInput); err != nil {
+			return forbiddenError(err)
+		}
+		child := CommentCreateInputToBoiler(childInput)
+		child.PostID = m.ID
+		if err := r.insertCommentCreateInput(
+			ctx,
+			exec,
+			child,
+			childInput,
+			rawChildrenComments[i],
+			dm.CommentColumns.PostID,
+		); err != nil {
+			return err
+		}
+	}
+	rawChildrenTags := GetNestedInputs(rawInput, "tags")
+	for i, childInput := range input.Tags {
+		if childInput == nil {
+			continue
+		}
+		if err := r.tagAuthorizer.CanCreate(ctx, childInput); err != nil {
+			return forbiddenError(err)
+		}
+		child := TagCreateInputToBoiler(childInput)
+		if err := r.insertTagCreateInput(ctx, exec, child, childInput, rawChildrenTags[i]); err != nil {
+			return err
+		}
+		if err := m.AddTags(ctx, exec, false, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertPostLikeCreateInput inserts the postLike together with the relations which are nested in the input, the
+// authorizers of the nested relations are asked before they are inserted
+func (r *Resolver) insertPostLikeCreateInput(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	m *dm.PostLike,
+	input *fm.PostLikeCreateInput,
+	rawInput map[string]interface{},
+	extraColumns ...string,
+) error {
+	if input.Post != nil {
+		if err := r.postAuthorizer.CanCreate(ctx, input.Post); err != nil {
+			return forbiddenError(err)
+		}
+		post := PostCreateInputToBoiler(input.Post)
+		if err := r.insertPostCreateInput(
+			ctx,
+			exec,
+			post,
+			input.Post,
+			GetNestedInput(rawInput, "post"),
+		); err != nil {
+			return err
+		}
+		m.PostID = post.ID
+	}
+	m.UserID = auth.UserIDFromContext(ctx)
+	extraColumns = append(extraColumns, dm.PostLikeColumns.UserID)
+	whiteList := PostLikeCreateInputToBoilerWhitelist(rawInput, extraColumns...)
+	if err := m.Insert(ctx, exec, whiteList); err != nil {
+		return err
+	}
+	return nil
+}
+
+// insertSettingCreateInput inserts the setting together with the relations which are nested in the input, the
+// authorizers of the nested relations are asked before they are inserted
+func (r *Resolver) insertSettingCreateInput(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	m *dm.Setting,
+	input *fm.SettingCreateInput,
+	rawInput map[string]interface{},
+	extraColumns ...string,
+) error {
+	whiteList := SettingCreateInputToBoilerWhitelist(rawInput, extraColumns...)
+	if err := m.Insert(ctx, exec, whiteList); err != nil {
+		return err
+	}
+	return nil
+}
+
+// insertTagCreateInput inserts the tag together with the relations which are nested in the input, the
+// authorizers of the nested relations are asked before they are inserted
+func (r *Resolver) insertTagCreateInput(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	m *dm.Tag,
+	input *fm.TagCreateInput,
+	rawInput map[string]interface{},
+	extraColumns ...string,
+) error {
+	whiteList := TagCreateInputToBoilerWhitelist(rawInput, extraColumns...)
+	if err := m.Insert(ctx, exec, whiteList); err != nil {
+		return err
+	}
+	return nil
+}
+
+// insertUserCreateInput inserts the user together with the relations which are nested in the input, the
+// authorizers of the nested relations are asked before they are inserted
+func (r *Resolver) insertUserCreateInput(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	m *dm.User,
+	input *fm.UserCreateInput,
+	rawInput map[string]interface{},
+	extraColumns ...string,
+) error {
+	m.OrganizationID = auth.OrganizationIDFromContext(ctx)
+	extraColumns = append(extraColumns, dm.UserColumns.OrganizationID)
+	whiteList := UserCreateInputToBoilerWhitelist(rawInput, extraColumns...)
+	if err := m.Insert(ctx, exec, whiteList); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Resolver) Mutation() fm.MutationResolver { return &mutationResolver{r} }
+func (r *Resolver) Query() fm.QueryResolver       { return &queryResolver{r} }
+
+type mutationResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
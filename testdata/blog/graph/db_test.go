@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	. "example.com/blog/helpers"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// TestSQLTxRollback checks that a mutation inside the transaction of the caller only rolls back its own changes
+func TestSQLTxRollback(t *testing.T) {
+	ctx := context.Background()
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlDB.Close()
+	if _, err := sqlDB.Exec("CREATE TABLE tags (name TEXT NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+	tx, err := sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+	db := SQLTx(tx)
+
+	for _, test := range []struct {
+		name   string
+		commit bool
+	}{{"committed", true}, {"rolled back", false}, {"committed after a rollback", true}} {
+		mutation, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := mutation.ExecContext(ctx, "INSERT INTO tags (name) VALUES (?)", test.name); err != nil {
+			t.Fatal(err)
+		}
+		if test.commit {
+			err = mutation.Commit()
+		} else {
+			err = mutation.Rollback()
+		}
+		if err != nil {
+			t.Fatalf("%v: %v", test.name, err)
+		}
+	}
+
+	rows, err := tx.QueryContext(ctx, "SELECT name FROM tags ORDER BY name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+	}
+	if len(names) != 2 || names[0] != "committed" || names[1] != "committed after a rollback" {
+		t.Errorf("only the committed tags should be in the transaction but there are %v", names)
+	}
+}
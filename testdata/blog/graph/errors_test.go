@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "example.com/blog/helpers"
+)
+
+func TestLogInputError(t *testing.T) {
+	var logged *ResolverError
+	r := NewResolver(nil, WithLogger(LoggerFunc(func(ctx context.Context, err *ResolverError) {
+		logged = err
+	})))
+	err := fmt.Errorf("models: unable to insert into users: %w", errors.New("UNIQUE constraint failed: users.email"))
+	publicError := r.logInputError(context.Background(), "User", "create", "could not create user", err,
+		UserCreateInputFields)
+
+	if publicError.Message != "could not create user" || publicError.Extensions["code"] != ErrorCodeConflict {
+		t.Errorf("the client should get the public message with CONFLICT but got %v %v", publicError.Message,
+			publicError.Extensions)
+	}
+	expectedFields := []FieldError{{Field: "email", Code: ErrorCodeConflict}}
+	if fields := publicError.Extensions["fields"]; !reflect.DeepEqual(fields, expectedFields) {
+		t.Errorf("fields should be %v but are %v", expectedFields, fields)
+	}
+	if logged == nil || logged.Err != err || logged.Code != ErrorCodeConflict || logged.Operation != "create" {
+		t.Errorf("the error should be logged with its details but got %+v", logged)
+	}
+}
+
+func TestLogErrorHidesDetails(t *testing.T) {
+	r := NewResolver(nil, WithLogger(LoggerFunc(func(ctx context.Context, err *ResolverError) {})))
+	tests := []struct {
+		err  error
+		code string
+	}{
+		{err: fmt.Errorf("models: failed to execute a one query for posts: %w", sql.ErrNoRows), code: ErrorCodeNotFound},
+		{err: fmt.Errorf("post 1: %w", ErrInvalidID), code: ErrorCodeValidation},
+		{err: errors.New("dial tcp 10.0.0.1:5432: connection refused"), code: ErrorCodeInternal},
+	}
+	for _, test := range tests {
+		publicError := r.logError(context.Background(), "Post", "single", "could not get post", test.err)
+		if publicError.Extensions["code"] != test.code {
+			t.Errorf("%v should have code %v but has %v", test.err, test.code, publicError.Extensions["code"])
+		}
+		if publicError.Message != "could not get post" || strings.Contains(publicError.Error(), test.err.Error()) {
+			t.Errorf("the client should only get the public message but got %v", publicError.Error())
+		}
+	}
+}
+
+func TestForbiddenError(t *testing.T) {
+	if err := forbiddenError(errors.New("not your post")); ErrorCode(err) != ErrorCodeForbidden {
+		t.Errorf("an error of an authorizer should have code FORBIDDEN but has %v", ErrorCode(err))
+	}
+	notFound := NewPublicError(ErrorCodeNotFound, "could not get post")
+	if err := forbiddenError(notFound); err != notFound {
+		t.Errorf("an error of an authorizer with a code should be returned as it is but got %v", err)
+	}
+}
+
+func TestInvalidID(t *testing.T) {
+	r := NewResolver(nil, WithLogger(LoggerFunc(func(ctx context.Context, err *ResolverError) {})))
+	if id, err := PostID(PostIDToGraphQL(1)); err != nil || id != 1 {
+		t.Errorf("the id of post 1 should be 1 but is %v %v", id, err)
+	}
+	// the id is checked before the database is used
+	for _, id := range []string{"1", "posts-", "posts-one", "posts--1", "comments-1", PostIDToGraphQL(1) + "0x"} {
+		if _, err := r.Query().Post(context.Background(), id, nil); ErrorCode(err) != ErrorCodeValidation {
+			t.Errorf("post %q should have code %v but has %v", id, ErrorCodeValidation, ErrorCode(err))
+		}
+		if _, err := r.Mutation().DeletePost(context.Background(), id, nil); ErrorCode(err) != ErrorCodeValidation {
+			t.Errorf("deleting post %q should have code %v but has %v", id, ErrorCodeValidation, ErrorCode(err))
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	fm "example.com/blog/graphql_models"
+	. "example.com/blog/helpers"
+	dm "example.com/blog/models"
+)
+
+func TestInsertRejectsScopedRelation(t *testing.T) {
+	r := NewResolver(nil)
+	input := &fm.CommentCreateInput{Content: "hi", User: &fm.UserCreateInput{Email: "a@example.com"}}
+	// the user is rejected before the database is used
+	err := r.insertCommentCreateInput(context.Background(), nil, &dm.Comment{}, input, nil)
+	if ErrorCode(err) != ErrorCodeValidation {
+		t.Errorf("a user nested in a comment should be rejected with VALIDATION but got %v", err)
+	}
+}
@@ -0,0 +1,26 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	fm "example.com/blog/graphql_models"
+	. "example.com/blog/helpers"
+	dm "example.com/blog/models"
+)
+
+const publicSettingCustomError = "could not get setting"
+
+// Setting returns an empty value for a setting which is not stored yet instead of the NOT_FOUND error of the
+// generated resolver, the plugin skips the resolvers which are implemented in other files
+func (r *queryResolver) Setting(ctx context.Context, id string) (*fm.Setting, error) {
+	m, err := dm.FindSetting(ctx, r.readDB(ctx), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &fm.Setting{ID: id}, nil
+	}
+	if err != nil {
+		return nil, r.logError(ctx, "Setting", "single", publicSettingCustomError, err)
+	}
+	return SettingToGraphQL(m), nil
+}
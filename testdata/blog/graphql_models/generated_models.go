@@ -0,0 +1,699 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graphql_models
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type BooleanFilter struct {
+	IsTrue     *bool `json:"isTrue"`
+	IsFalse    *bool `json:"isFalse"`
+	IsNull     *bool `json:"isNull"`
+	EqualTo    *bool `json:"equalTo"`
+	NotEqualTo *bool `json:"notEqualTo"`
+}
+
+type Comment struct {
+	ID      string `json:"id"`
+	Content string `json:"content"`
+	Post    *Post  `json:"post"`
+	User    *User  `json:"user"`
+}
+
+type CommentConnection struct {
+	Edges    []*CommentEdge `json:"edges"`
+	PageInfo *PageInfo      `json:"pageInfo"`
+}
+
+type CommentCreateInput struct {
+	Content string           `json:"content"`
+	PostID  *string          `json:"postId"`
+	UserID  *string          `json:"userId"`
+	User    *UserCreateInput `json:"user"`
+}
+
+type CommentDeletePayload struct {
+	ID string `json:"id"`
+}
+
+type CommentEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Comment `json:"node"`
+}
+
+type CommentFilter struct {
+	Search *string       `json:"search"`
+	Where  *CommentWhere `json:"where"`
+}
+
+type CommentOrderBy struct {
+	Field     CommentOrderByField `json:"field"`
+	Direction SortDirection       `json:"direction"`
+}
+
+type CommentPayload struct {
+	Comment *Comment `json:"comment"`
+}
+
+type CommentUpdateInput struct {
+	Content *string `json:"content"`
+	PostID  *string `json:"postId"`
+	UserID  *string `json:"userId"`
+}
+
+type CommentWhere struct {
+	ID      *IDFilter     `json:"id"`
+	Content *StringFilter `json:"content"`
+	Post    *PostWhere    `json:"post"`
+	User    *UserWhere    `json:"user"`
+	Or      *CommentWhere `json:"or"`
+	And     *CommentWhere `json:"and"`
+}
+
+type FloatFilter struct {
+	EqualTo           *float64  `json:"equalTo"`
+	NotEqualTo        *float64  `json:"notEqualTo"`
+	LessThan          *float64  `json:"lessThan"`
+	LessThanOrEqualTo *float64  `json:"lessThanOrEqualTo"`
+	MoreThan          *float64  `json:"moreThan"`
+	MoreThanOrEqualTo *float64  `json:"moreThanOrEqualTo"`
+	In                []float64 `json:"in"`
+	NotIn             []float64 `json:"notIn"`
+}
+
+type IDFilter struct {
+	EqualTo    *string  `json:"equalTo"`
+	NotEqualTo *string  `json:"notEqualTo"`
+	In         []string `json:"in"`
+	NotIn      []string `json:"notIn"`
+}
+
+type IntFilter struct {
+	EqualTo           *int  `json:"equalTo"`
+	NotEqualTo        *int  `json:"notEqualTo"`
+	LessThan          *int  `json:"lessThan"`
+	LessThanOrEqualTo *int  `json:"lessThanOrEqualTo"`
+	MoreThan          *int  `json:"moreThan"`
+	MoreThanOrEqualTo *int  `json:"moreThanOrEqualTo"`
+	In                []int `json:"in"`
+	NotIn             []int `json:"notIn"`
+}
+
+type Organization struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type OrganizationOrderBy struct {
+	Field     OrganizationOrderByField `json:"field"`
+	Direction SortDirection            `json:"direction"`
+}
+
+type OrganizationWhere struct {
+	ID   *IDFilter          `json:"id"`
+	Name *StringFilter      `json:"name"`
+	Or   *OrganizationWhere `json:"or"`
+	And  *OrganizationWhere `json:"and"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type Post struct {
+	ID           string        `json:"id"`
+	Title        string        `json:"title"`
+	Content      string        `json:"content"`
+	User         *User         `json:"user"`
+	Organization *Organization `json:"organization"`
+	Comments     []*Comment    `json:"comments"`
+	Tags         []*Tag        `json:"tags"`
+	CreatedAt    *int          `json:"createdAt"`
+}
+
+type PostCreateInput struct {
+	Title    string                `json:"title"`
+	Content  string                `json:"content"`
+	UserID   string                `json:"userId"`
+	Comments []*CommentCreateInput `json:"comments"`
+	Tags     []*TagCreateInput     `json:"tags"`
+}
+
+type PostDeletePayload struct {
+	ID string `json:"id"`
+}
+
+type PostFilter struct {
+	Search *string    `json:"search"`
+	Where  *PostWhere `json:"where"`
+}
+
+type PostLike struct {
+	ID   string `json:"id"`
+	Note string `json:"note"`
+	Post *Post  `json:"post"`
+	User *User  `json:"user"`
+}
+
+type PostLikeConnection struct {
+	Edges    []*PostLikeEdge `json:"edges"`
+	PageInfo *PageInfo       `json:"pageInfo"`
+}
+
+type PostLikeCreateInput struct {
+	Note   string           `json:"note"`
+	PostID *string          `json:"postId"`
+	UserID string           `json:"userId"`
+	Post   *PostCreateInput `json:"post"`
+}
+
+type PostLikeDeletePayload struct {
+	ID string `json:"id"`
+}
+
+type PostLikeEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *PostLike `json:"node"`
+}
+
+type PostLikeFilter struct {
+	Search *string        `json:"search"`
+	Where  *PostLikeWhere `json:"where"`
+}
+
+type PostLikeOrderBy struct {
+	Field     PostLikeOrderByField `json:"field"`
+	Direction SortDirection        `json:"direction"`
+}
+
+type PostLikePayload struct {
+	PostLike *PostLike `json:"postLike"`
+}
+
+type PostLikeUpdateInput struct {
+	Note *string `json:"note"`
+}
+
+type PostLikeWhere struct {
+	ID   *IDFilter      `json:"id"`
+	Note *StringFilter  `json:"note"`
+	Post *PostWhere     `json:"post"`
+	User *UserWhere     `json:"user"`
+	Or   *PostLikeWhere `json:"or"`
+	And  *PostLikeWhere `json:"and"`
+}
+
+type PostLikesDeletePayload struct {
+	Ids []string `json:"ids"`
+}
+
+type PostLikesPayload struct {
+	PostLikes []*PostLike `json:"postLikes"`
+}
+
+type PostOrderBy struct {
+	Field     PostOrderByField `json:"field"`
+	Direction SortDirection    `json:"direction"`
+}
+
+type PostPayload struct {
+	Post *Post `json:"post"`
+}
+
+type PostUpdateInput struct {
+	Title   *string `json:"title"`
+	Content *string `json:"content"`
+	UserID  *string `json:"userId"`
+}
+
+type PostWhere struct {
+	ID           *IDFilter          `json:"id"`
+	Title        *StringFilter      `json:"title"`
+	Content      *StringFilter      `json:"content"`
+	User         *UserWhere         `json:"user"`
+	Organization *OrganizationWhere `json:"organization"`
+	Comments     *CommentWhere      `json:"comments"`
+	Or           *PostWhere         `json:"or"`
+	And          *PostWhere         `json:"and"`
+}
+
+type PostsDeletePayload struct {
+	Ids []string `json:"ids"`
+}
+
+type PostsPayload struct {
+	Posts []*Post `json:"posts"`
+}
+
+type PostsUpdatePayload struct {
+	Ok bool `json:"ok"`
+}
+
+type Setting struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
+
+type SettingCreateInput struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
+
+type SettingDeletePayload struct {
+	ID string `json:"id"`
+}
+
+type SettingOrderBy struct {
+	Field     SettingOrderByField `json:"field"`
+	Direction SortDirection       `json:"direction"`
+}
+
+type SettingPayload struct {
+	Setting *Setting `json:"setting"`
+}
+
+type SettingUpdateInput struct {
+	Value *string `json:"value"`
+}
+
+type SettingsDeletePayload struct {
+	Ids []string `json:"ids"`
+}
+
+type StringFilter struct {
+	EqualTo            *string  `json:"equalTo"`
+	NotEqualTo         *string  `json:"notEqualTo"`
+	In                 []string `json:"in"`
+	NotIn              []string `json:"notIn"`
+	StartWith          *string  `json:"startWith"`
+	NotStartWith       *string  `json:"notStartWith"`
+	EndWith            *string  `json:"endWith"`
+	NotEndWith         *string  `json:"notEndWith"`
+	Contain            *string  `json:"contain"`
+	NotContain         *string  `json:"notContain"`
+	StartWithStrict    *string  `json:"startWithStrict"`
+	NotStartWithStrict *string  `json:"notStartWithStrict"`
+	EndWithStrict      *string  `json:"endWithStrict"`
+	NotEndWithStrict   *string  `json:"notEndWithStrict"`
+	ContainStrict      *string  `json:"containStrict"`
+	NotContainStrict   *string  `json:"notContainStrict"`
+}
+
+type Tag struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type TagCreateInput struct {
+	Name string `json:"name"`
+}
+
+type TagOrderBy struct {
+	Field     TagOrderByField `json:"field"`
+	Direction SortDirection   `json:"direction"`
+}
+
+type TagPayload struct {
+	Tag *Tag `json:"tag"`
+}
+
+type User struct {
+	ID           string        `json:"id"`
+	Email        string        `json:"email"`
+	FirstName    string        `json:"firstName"`
+	Organization *Organization `json:"organization"`
+}
+
+type UserCreateInput struct {
+	Email          string `json:"email"`
+	FirstName      string `json:"firstName"`
+	OrganizationID string `json:"organizationId"`
+}
+
+type UserOrderBy struct {
+	Field     UserOrderByField `json:"field"`
+	Direction SortDirection    `json:"direction"`
+}
+
+type UserWhere struct {
+	ID           *IDFilter          `json:"id"`
+	Email        *StringFilter      `json:"email"`
+	FirstName    *StringFilter      `json:"firstName"`
+	Organization *OrganizationWhere `json:"organization"`
+	Or           *UserWhere         `json:"or"`
+	And          *UserWhere         `json:"and"`
+}
+
+type CommentOrderByField string
+
+const (
+	CommentOrderByFieldID      CommentOrderByField = "ID"
+	CommentOrderByFieldContent CommentOrderByField = "CONTENT"
+	CommentOrderByFieldPostID  CommentOrderByField = "POST_ID"
+	CommentOrderByFieldUserID  CommentOrderByField = "USER_ID"
+)
+
+var AllCommentOrderByField = []CommentOrderByField{
+	CommentOrderByFieldID,
+	CommentOrderByFieldContent,
+	CommentOrderByFieldPostID,
+	CommentOrderByFieldUserID,
+}
+
+func (e CommentOrderByField) IsValid() bool {
+	switch e {
+	case CommentOrderByFieldID, CommentOrderByFieldContent, CommentOrderByFieldPostID, CommentOrderByFieldUserID:
+		return true
+	}
+	return false
+}
+
+func (e CommentOrderByField) String() string {
+	return string(e)
+}
+
+func (e *CommentOrderByField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentOrderByField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentOrderByField", str)
+	}
+	return nil
+}
+
+func (e CommentOrderByField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OrganizationOrderByField string
+
+const (
+	OrganizationOrderByFieldID   OrganizationOrderByField = "ID"
+	OrganizationOrderByFieldName OrganizationOrderByField = "NAME"
+)
+
+var AllOrganizationOrderByField = []OrganizationOrderByField{
+	OrganizationOrderByFieldID,
+	OrganizationOrderByFieldName,
+}
+
+func (e OrganizationOrderByField) IsValid() bool {
+	switch e {
+	case OrganizationOrderByFieldID, OrganizationOrderByFieldName:
+		return true
+	}
+	return false
+}
+
+func (e OrganizationOrderByField) String() string {
+	return string(e)
+}
+
+func (e *OrganizationOrderByField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrganizationOrderByField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrganizationOrderByField", str)
+	}
+	return nil
+}
+
+func (e OrganizationOrderByField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PostLikeOrderByField string
+
+const (
+	PostLikeOrderByFieldPostID PostLikeOrderByField = "POST_ID"
+	PostLikeOrderByFieldUserID PostLikeOrderByField = "USER_ID"
+	PostLikeOrderByFieldNote   PostLikeOrderByField = "NOTE"
+)
+
+var AllPostLikeOrderByField = []PostLikeOrderByField{
+	PostLikeOrderByFieldPostID,
+	PostLikeOrderByFieldUserID,
+	PostLikeOrderByFieldNote,
+}
+
+func (e PostLikeOrderByField) IsValid() bool {
+	switch e {
+	case PostLikeOrderByFieldPostID, PostLikeOrderByFieldUserID, PostLikeOrderByFieldNote:
+		return true
+	}
+	return false
+}
+
+func (e PostLikeOrderByField) String() string {
+	return string(e)
+}
+
+func (e *PostLikeOrderByField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PostLikeOrderByField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PostLikeOrderByField", str)
+	}
+	return nil
+}
+
+func (e PostLikeOrderByField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PostOrderByField string
+
+const (
+	PostOrderByFieldID             PostOrderByField = "ID"
+	PostOrderByFieldTitle          PostOrderByField = "TITLE"
+	PostOrderByFieldContent        PostOrderByField = "CONTENT"
+	PostOrderByFieldUserID         PostOrderByField = "USER_ID"
+	PostOrderByFieldOrganizationID PostOrderByField = "ORGANIZATION_ID"
+	PostOrderByFieldCreatedAt      PostOrderByField = "CREATED_AT"
+	PostOrderByFieldDeletedAt      PostOrderByField = "DELETED_AT"
+)
+
+var AllPostOrderByField = []PostOrderByField{
+	PostOrderByFieldID,
+	PostOrderByFieldTitle,
+	PostOrderByFieldContent,
+	PostOrderByFieldUserID,
+	PostOrderByFieldOrganizationID,
+	PostOrderByFieldCreatedAt,
+	PostOrderByFieldDeletedAt,
+}
+
+func (e PostOrderByField) IsValid() bool {
+	switch e {
+	case PostOrderByFieldID, PostOrderByFieldTitle, PostOrderByFieldContent, PostOrderByFieldUserID, PostOrderByFieldOrganizationID, PostOrderByFieldCreatedAt, PostOrderByFieldDeletedAt:
+		return true
+	}
+	return false
+}
+
+func (e PostOrderByField) String() string {
+	return string(e)
+}
+
+func (e *PostOrderByField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PostOrderByField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PostOrderByField", str)
+	}
+	return nil
+}
+
+func (e PostOrderByField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SettingOrderByField string
+
+const (
+	SettingOrderByFieldKey   SettingOrderByField = "KEY"
+	SettingOrderByFieldValue SettingOrderByField = "VALUE"
+)
+
+var AllSettingOrderByField = []SettingOrderByField{
+	SettingOrderByFieldKey,
+	SettingOrderByFieldValue,
+}
+
+func (e SettingOrderByField) IsValid() bool {
+	switch e {
+	case SettingOrderByFieldKey, SettingOrderByFieldValue:
+		return true
+	}
+	return false
+}
+
+func (e SettingOrderByField) String() string {
+	return string(e)
+}
+
+func (e *SettingOrderByField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SettingOrderByField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SettingOrderByField", str)
+	}
+	return nil
+}
+
+func (e SettingOrderByField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TagOrderByField string
+
+const (
+	TagOrderByFieldID   TagOrderByField = "ID"
+	TagOrderByFieldName TagOrderByField = "NAME"
+)
+
+var AllTagOrderByField = []TagOrderByField{
+	TagOrderByFieldID,
+	TagOrderByFieldName,
+}
+
+func (e TagOrderByField) IsValid() bool {
+	switch e {
+	case TagOrderByFieldID, TagOrderByFieldName:
+		return true
+	}
+	return false
+}
+
+func (e TagOrderByField) String() string {
+	return string(e)
+}
+
+func (e *TagOrderByField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TagOrderByField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TagOrderByField", str)
+	}
+	return nil
+}
+
+func (e TagOrderByField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UserOrderByField string
+
+const (
+	UserOrderByFieldID             UserOrderByField = "ID"
+	UserOrderByFieldEmail          UserOrderByField = "EMAIL"
+	UserOrderByFieldFirstName      UserOrderByField = "FIRST_NAME"
+	UserOrderByFieldOrganizationID UserOrderByField = "ORGANIZATION_ID"
+)
+
+var AllUserOrderByField = []UserOrderByField{
+	UserOrderByFieldID,
+	UserOrderByFieldEmail,
+	UserOrderByFieldFirstName,
+	UserOrderByFieldOrganizationID,
+}
+
+func (e UserOrderByField) IsValid() bool {
+	switch e {
+	case UserOrderByFieldID, UserOrderByFieldEmail, UserOrderByFieldFirstName, UserOrderByFieldOrganizationID:
+		return true
+	}
+	return false
+}
+
+func (e UserOrderByField) String() string {
+	return string(e)
+}
+
+func (e *UserOrderByField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserOrderByField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserOrderByField", str)
+	}
+	return nil
+}
+
+func (e UserOrderByField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
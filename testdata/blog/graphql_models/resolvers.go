@@ -0,0 +1,38 @@
+// The resolver interfaces of the exec file of gqlgen, the rest of the exec file is left out of testdata/blog.
+
+package graphql_models
+
+import "context"
+
+type MutationResolver interface {
+	CreatePostLike(ctx context.Context, input PostLikeCreateInput) (*PostLikePayload, error)
+	CreatePostLikes(ctx context.Context, input []*PostLikeCreateInput) (*PostLikesPayload, error)
+	UpdatePostLike(ctx context.Context, id string, input PostLikeUpdateInput) (*PostLikePayload, error)
+	DeletePostLike(ctx context.Context, id string) (*PostLikeDeletePayload, error)
+	DeletePostLikes(ctx context.Context, filter *PostLikeFilter) (*PostLikesDeletePayload, error)
+	CreateSetting(ctx context.Context, input SettingCreateInput) (*SettingPayload, error)
+	UpdateSetting(ctx context.Context, id string, input SettingUpdateInput) (*SettingPayload, error)
+	DeleteSetting(ctx context.Context, id string) (*SettingDeletePayload, error)
+	CreatePost(ctx context.Context, input PostCreateInput) (*PostPayload, error)
+	CreatePosts(ctx context.Context, input []*PostCreateInput) (*PostsPayload, error)
+	UpdatePost(ctx context.Context, id string, input PostUpdateInput) (*PostPayload, error)
+	UpdatePosts(ctx context.Context, filter *PostFilter, input PostUpdateInput) (*PostsUpdatePayload, error)
+	DeletePost(ctx context.Context, id string, hardDelete *bool) (*PostDeletePayload, error)
+	DeletePosts(ctx context.Context, filter *PostFilter) (*PostsDeletePayload, error)
+	CreateComment(ctx context.Context, input CommentCreateInput) (*CommentPayload, error)
+	UpdateComment(ctx context.Context, id string, input CommentUpdateInput) (*CommentPayload, error)
+	DeleteComment(ctx context.Context, id string) (*CommentDeletePayload, error)
+	CreateTag(ctx context.Context, input TagCreateInput) (*TagPayload, error)
+	AddPostTags(ctx context.Context, postID string, tagIds []string) (*PostPayload, error)
+	RemovePostTags(ctx context.Context, postID string, tagIds []string) (*PostPayload, error)
+	SetPostTags(ctx context.Context, postID string, tagIds []string) (*PostPayload, error)
+}
+type QueryResolver interface {
+	PostLike(ctx context.Context, id string) (*PostLike, error)
+	PostLikes(ctx context.Context, first *int, after *string, last *int, before *string, filter *PostLikeFilter) (*PostLikeConnection, error)
+	Setting(ctx context.Context, id string) (*Setting, error)
+	Post(ctx context.Context, id string, withDeleted *bool) (*Post, error)
+	Posts(ctx context.Context, filter *PostFilter, orderBy []*PostOrderBy, withDeleted bool) ([]*Post, error)
+	Comment(ctx context.Context, id string) (*Comment, error)
+	Comments(ctx context.Context, first *int, after *string, last *int, before *string, filter *CommentFilter, orderBy []*CommentOrderBy) (*CommentConnection, error)
+}
@@ -0,0 +1,757 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"example.com/blog/graphql_models"
+	"example.com/blog/models"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/web-ridge/utils-go/boilergql"
+)
+
+// const regexSign = `'`
+const percentSign = `%`
+
+func startsWithValue(v string) string { return v + percentSign }
+func endsWithValue(v string) string   { return percentSign + v }
+func containsValue(v string) string   { return percentSign + v + percentSign }
+
+const isLike = " LIKE ?"
+const in = " IN ?"
+const notIn = " NOT IN ?"
+
+// SortColumn is a column the results are ordered on
+type SortColumn struct {
+	Name       string
+	Descending bool
+}
+
+func quoteColumn(column string) string {
+	return "\"" + column + "\""
+}
+
+func SortColumnsToMods(columns []SortColumn) []qm.QueryMod {
+	if len(columns) == 0 {
+		return nil
+	}
+	orderBy := make([]string, len(columns))
+	for i, column := range columns {
+		orderBy[i] = quoteColumn(column.Name)
+		if column.Descending {
+			orderBy[i] += " DESC"
+		} else {
+			orderBy[i] += " ASC"
+		}
+	}
+	return []qm.QueryMod{qm.OrderBy(strings.Join(orderBy, ", "))}
+}
+
+func appendSubQuery(queryMods []qm.QueryMod, q *queries.Query) []qm.QueryMod {
+	// TODO: integrate with subquery in sqlboiler if it will be released in the future
+
+	qs, args := queries.BuildQuery(q)
+	qsClean := strings.TrimSuffix(qs, ";")
+	return append(queryMods, qm.Where(fmt.Sprintf("EXISTS(%v)", qsClean), args...))
+}
+
+func BooleanFilterToMods(m *graphql_models.BooleanFilter, column string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+	if m.EqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.EQ, *m.EqualTo))
+	}
+	if m.NotEqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.NEQ, *m.NotEqualTo))
+	}
+	return queryMods
+}
+
+func IDFilterToMods(m *graphql_models.IDFilter, column string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+	if m.EqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.EQ, *m.EqualTo))
+	}
+	if m.NotEqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.NEQ, *m.NotEqualTo))
+	}
+	if len(m.In) > 0 {
+		queryMods = append(queryMods, qm.WhereIn(column+in, boilergql.StringsToInterfaces(m.In)...))
+	}
+	if len(m.NotIn) > 0 {
+		queryMods = append(queryMods, qm.WhereIn(column+notIn, boilergql.StringsToInterfaces(m.NotIn)...))
+	}
+	return queryMods
+}
+
+func StringFilterToMods(m *graphql_models.StringFilter, column string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+
+	var queryMods []qm.QueryMod
+	if m.EqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.EQ, *m.EqualTo))
+	}
+	if m.NotEqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.NEQ, *m.NotEqualTo))
+	}
+
+	lowerColumn := "LOWER(" + column + ")"
+	if m.StartWith != nil {
+		queryMods = append(queryMods, qm.Where(lowerColumn+isLike, startsWithValue(strings.ToLower(*m.StartWith))))
+	}
+	if m.EndWith != nil {
+		queryMods = append(queryMods, qm.Where(lowerColumn+isLike, endsWithValue(strings.ToLower(*m.EndWith))))
+	}
+	if m.Contain != nil {
+		queryMods = append(queryMods, qm.Where(lowerColumn+isLike, containsValue(strings.ToLower(*m.Contain))))
+	}
+
+	if m.StartWithStrict != nil {
+		queryMods = append(queryMods, qm.Where(column+isLike, startsWithValue(*m.StartWithStrict)))
+	}
+	if m.EndWithStrict != nil {
+		queryMods = append(queryMods, qm.Where(column+isLike, endsWithValue(*m.EndWithStrict)))
+	}
+	if m.ContainStrict != nil {
+		queryMods = append(queryMods, qm.Where(column+isLike, containsValue(*m.ContainStrict)))
+	}
+
+	if len(m.In) > 0 {
+		queryMods = append(queryMods, qm.WhereIn(column+in, boilergql.IDsToBoilerInterfaces(m.In)...))
+	}
+	if len(m.NotIn) > 0 {
+		queryMods = append(queryMods, qm.WhereIn(column+notIn, boilergql.IDsToBoilerInterfaces(m.NotIn)...))
+	}
+
+	return queryMods
+}
+
+func FloatFilterToMods(m *graphql_models.FloatFilter, column string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+	if m.EqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.EQ, *m.EqualTo))
+	}
+	if m.NotEqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.NEQ, *m.NotEqualTo))
+	}
+	if m.LessThan != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.LT, *m.LessThan))
+	}
+	if m.MoreThan != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.GT, *m.MoreThan))
+	}
+	if m.LessThanOrEqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.LTE, *m.LessThanOrEqualTo))
+	}
+	if m.MoreThanOrEqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.GTE, *m.MoreThanOrEqualTo))
+	}
+	if len(m.In) > 0 {
+		queryMods = append(queryMods, qm.WhereIn(column+in, boilergql.FloatsToInterfaces(m.In)...))
+	}
+	if len(m.NotIn) > 0 {
+		queryMods = append(queryMods, qm.WhereIn(column+notIn, boilergql.FloatsToInterfaces(m.NotIn)...))
+	}
+	return queryMods
+}
+
+func IntFilterToMods(m *graphql_models.IntFilter, column string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+	if m.EqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.EQ, *m.EqualTo))
+	}
+	if m.NotEqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.NEQ, *m.NotEqualTo))
+	}
+	if m.LessThan != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.LT, *m.LessThan))
+	}
+	if m.MoreThan != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.GT, *m.MoreThan))
+	}
+	if m.LessThanOrEqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.LTE, *m.LessThanOrEqualTo))
+	}
+	if m.MoreThanOrEqualTo != nil {
+		queryMods = append(queryMods, qmhelper.Where(column, qmhelper.GTE, *m.MoreThanOrEqualTo))
+	}
+	if len(m.In) > 0 {
+		queryMods = append(queryMods, qm.WhereIn(column+in, boilergql.IntsToInterfaces(m.In)...))
+	}
+	if len(m.NotIn) > 0 {
+		queryMods = append(queryMods, qm.WhereIn(column+notIn, boilergql.IntsToInterfaces(m.NotIn)...))
+	}
+	return queryMods
+}
+
+func CommentFilterToMods(m *graphql_models.CommentFilter) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	if m.Search != nil || m.Where != nil {
+		var queryMods []qm.QueryMod
+		queryMods = append(queryMods, CommentSearchToMods(m.Search)...)
+		queryMods = append(queryMods, CommentWhereToMods(m.Where, true, "")...)
+		if len(queryMods) > 0 {
+			return []qm.QueryMod{
+				qm.Expr(queryMods...),
+			}
+		}
+	}
+	return nil
+}
+func CommentSearchToMods(search *string) []qm.QueryMod {
+	// TODO: implement your own custom search here
+	return nil
+}
+
+func CommentOrderByFieldToColumn(v graphql_models.CommentOrderByField) string {
+	switch v {
+	case graphql_models.CommentOrderByFieldID:
+		return models.CommentColumns.ID
+	case graphql_models.CommentOrderByFieldContent:
+		return models.CommentColumns.Content
+	case graphql_models.CommentOrderByFieldPostID:
+		return models.CommentColumns.PostID
+	case graphql_models.CommentOrderByFieldUserID:
+		return models.CommentColumns.UserID
+	}
+	return ""
+}
+
+func CommentOrderByToSortColumns(orderBy []*graphql_models.CommentOrderBy) []SortColumn {
+	columns := make([]SortColumn, 0, len(orderBy))
+	for _, o := range orderBy {
+		if o == nil {
+			continue
+		}
+		column := CommentOrderByFieldToColumn(o.Field)
+		if column == "" {
+			continue
+		}
+		columns = append(columns, SortColumn{
+			Name:       column,
+			Descending: o.Direction == graphql_models.SortDirectionDesc,
+		})
+	}
+	return columns
+}
+
+func CommentOrderByToMods(orderBy []*graphql_models.CommentOrderBy) []qm.QueryMod {
+	return SortColumnsToMods(CommentOrderByToSortColumns(orderBy))
+}
+
+func CommentWhereSubqueryToMods(m *graphql_models.CommentWhere, foreignColumn string, parentTable string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+
+	// if foreign key exist so we can filter on ID in the root table instead of subquery
+	hasForeignKeyInRoot := foreignColumn != ""
+	if hasForeignKeyInRoot {
+		queryMods = append(queryMods, IDFilterToMods(m.ID, foreignColumn)...)
+	}
+
+	subQueryMods := CommentWhereToMods(m, !hasForeignKeyInRoot, parentTable)
+	if len(subQueryMods) > 0 {
+		subQuery := models.Comments(append(subQueryMods, qm.Select("1"))...)
+		queryMods = appendSubQuery(queryMods, subQuery.Query)
+	}
+	return queryMods
+}
+
+func CommentWhereToMods(m *graphql_models.CommentWhere, withPrimaryID bool, parentTable string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+
+	if withPrimaryID {
+		queryMods = append(queryMods, IDFilterToMods(m.ID, models.CommentColumns.ID)...)
+	}
+	queryMods = append(queryMods, StringFilterToMods(m.Content, models.CommentColumns.Content)...)
+	queryMods = append(queryMods, PostWhereSubqueryToMods(m.Post, models.CommentColumns.PostID, models.TableNames.Comments)...)
+	queryMods = append(queryMods, UserWhereSubqueryToMods(m.User, models.CommentColumns.UserID, models.TableNames.Comments)...)
+	if m.Or != nil {
+		queryMods = append(queryMods, qm.Or2(qm.Expr(CommentWhereToMods(m.Or, true, "")...)))
+	}
+	if m.And != nil {
+		queryMods = append(queryMods, qm.Expr(CommentWhereToMods(m.And, true, "")...))
+	}
+
+	if len(queryMods) > 0 && parentTable != "" {
+
+		if parentTable == models.TableNames.Posts {
+			queryMods = append(queryMods, qm.Where(fmt.Sprintf("%v.%v = %v.id", models.TableNames.Comments, models.CommentColumns.PostID, parentTable)))
+		}
+		if parentTable == models.TableNames.Users {
+			queryMods = append(queryMods, qm.Where(fmt.Sprintf("%v.%v = %v.id", models.TableNames.Comments, models.CommentColumns.UserID, parentTable)))
+		}
+	}
+
+	return queryMods
+}
+
+func OrganizationOrderByFieldToColumn(v graphql_models.OrganizationOrderByField) string {
+	switch v {
+	case graphql_models.OrganizationOrderByFieldID:
+		return models.OrganizationColumns.ID
+	case graphql_models.OrganizationOrderByFieldName:
+		return models.OrganizationColumns.Name
+	}
+	return ""
+}
+
+func OrganizationOrderByToSortColumns(orderBy []*graphql_models.OrganizationOrderBy) []SortColumn {
+	columns := make([]SortColumn, 0, len(orderBy))
+	for _, o := range orderBy {
+		if o == nil {
+			continue
+		}
+		column := OrganizationOrderByFieldToColumn(o.Field)
+		if column == "" {
+			continue
+		}
+		columns = append(columns, SortColumn{
+			Name:       column,
+			Descending: o.Direction == graphql_models.SortDirectionDesc,
+		})
+	}
+	return columns
+}
+
+func OrganizationOrderByToMods(orderBy []*graphql_models.OrganizationOrderBy) []qm.QueryMod {
+	return SortColumnsToMods(OrganizationOrderByToSortColumns(orderBy))
+}
+
+func OrganizationWhereSubqueryToMods(m *graphql_models.OrganizationWhere, foreignColumn string, parentTable string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+
+	// if foreign key exist so we can filter on ID in the root table instead of subquery
+	hasForeignKeyInRoot := foreignColumn != ""
+	if hasForeignKeyInRoot {
+		queryMods = append(queryMods, IDFilterToMods(m.ID, foreignColumn)...)
+	}
+
+	subQueryMods := OrganizationWhereToMods(m, !hasForeignKeyInRoot, parentTable)
+	if len(subQueryMods) > 0 {
+		subQuery := models.Organizations(append(subQueryMods, qm.Select("1"))...)
+		queryMods = appendSubQuery(queryMods, subQuery.Query)
+	}
+	return queryMods
+}
+
+func OrganizationWhereToMods(m *graphql_models.OrganizationWhere, withPrimaryID bool, parentTable string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+
+	if withPrimaryID {
+		queryMods = append(queryMods, IDFilterToMods(m.ID, models.OrganizationColumns.ID)...)
+	}
+	queryMods = append(queryMods, StringFilterToMods(m.Name, models.OrganizationColumns.Name)...)
+	if m.Or != nil {
+		queryMods = append(queryMods, qm.Or2(qm.Expr(OrganizationWhereToMods(m.Or, true, "")...)))
+	}
+	if m.And != nil {
+		queryMods = append(queryMods, qm.Expr(OrganizationWhereToMods(m.And, true, "")...))
+	}
+
+	if len(queryMods) > 0 && parentTable != "" {
+
+	}
+
+	return queryMods
+}
+
+func PostFilterToMods(m *graphql_models.PostFilter) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	if m.Search != nil || m.Where != nil {
+		var queryMods []qm.QueryMod
+		queryMods = append(queryMods, PostSearchToMods(m.Search)...)
+		queryMods = append(queryMods, PostWhereToMods(m.Where, true, "")...)
+		if len(queryMods) > 0 {
+			return []qm.QueryMod{
+				qm.Expr(queryMods...),
+			}
+		}
+	}
+	return nil
+}
+func PostSearchToMods(search *string) []qm.QueryMod {
+	// TODO: implement your own custom search here
+	return nil
+}
+
+func PostLikeFilterToMods(m *graphql_models.PostLikeFilter) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	if m.Search != nil || m.Where != nil {
+		var queryMods []qm.QueryMod
+		queryMods = append(queryMods, PostLikeSearchToMods(m.Search)...)
+		queryMods = append(queryMods, PostLikeWhereToMods(m.Where, true, "")...)
+		if len(queryMods) > 0 {
+			return []qm.QueryMod{
+				qm.Expr(queryMods...),
+			}
+		}
+	}
+	return nil
+}
+func PostLikeSearchToMods(search *string) []qm.QueryMod {
+	// TODO: implement your own custom search here
+	return nil
+}
+
+func PostLikeOrderByFieldToColumn(v graphql_models.PostLikeOrderByField) string {
+	switch v {
+	case graphql_models.PostLikeOrderByFieldPostID:
+		return models.PostLikeColumns.PostID
+	case graphql_models.PostLikeOrderByFieldUserID:
+		return models.PostLikeColumns.UserID
+	case graphql_models.PostLikeOrderByFieldNote:
+		return models.PostLikeColumns.Note
+	}
+	return ""
+}
+
+func PostLikeOrderByToSortColumns(orderBy []*graphql_models.PostLikeOrderBy) []SortColumn {
+	columns := make([]SortColumn, 0, len(orderBy))
+	for _, o := range orderBy {
+		if o == nil {
+			continue
+		}
+		column := PostLikeOrderByFieldToColumn(o.Field)
+		if column == "" {
+			continue
+		}
+		columns = append(columns, SortColumn{
+			Name:       column,
+			Descending: o.Direction == graphql_models.SortDirectionDesc,
+		})
+	}
+	return columns
+}
+
+func PostLikeOrderByToMods(orderBy []*graphql_models.PostLikeOrderBy) []qm.QueryMod {
+	return SortColumnsToMods(PostLikeOrderByToSortColumns(orderBy))
+}
+
+func PostLikeWhereSubqueryToMods(m *graphql_models.PostLikeWhere, foreignColumn string, parentTable string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+
+	// if foreign key exist so we can filter on ID in the root table instead of subquery
+	hasForeignKeyInRoot := foreignColumn != ""
+	if hasForeignKeyInRoot {
+		queryMods = append(queryMods, IDFilterToMods(m.ID, foreignColumn)...)
+	}
+
+	subQueryMods := PostLikeWhereToMods(m, !hasForeignKeyInRoot, parentTable)
+	if len(subQueryMods) > 0 {
+		subQuery := models.PostLikes(append(subQueryMods, qm.Select("1"))...)
+		queryMods = appendSubQuery(queryMods, subQuery.Query)
+	}
+	return queryMods
+}
+
+func PostLikeWhereToMods(m *graphql_models.PostLikeWhere, withPrimaryID bool, parentTable string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+
+	queryMods = append(queryMods, StringFilterToMods(m.Note, models.PostLikeColumns.Note)...)
+	queryMods = append(queryMods, PostWhereSubqueryToMods(m.Post, models.PostLikeColumns.PostID, models.TableNames.PostLikes)...)
+	queryMods = append(queryMods, UserWhereSubqueryToMods(m.User, models.PostLikeColumns.UserID, models.TableNames.PostLikes)...)
+	if m.Or != nil {
+		queryMods = append(queryMods, qm.Or2(qm.Expr(PostLikeWhereToMods(m.Or, true, "")...)))
+	}
+	if m.And != nil {
+		queryMods = append(queryMods, qm.Expr(PostLikeWhereToMods(m.And, true, "")...))
+	}
+
+	if len(queryMods) > 0 && parentTable != "" {
+
+		if parentTable == models.TableNames.Posts {
+			queryMods = append(queryMods, qm.Where(fmt.Sprintf("%v.%v = %v.id", models.TableNames.PostLikes, models.PostLikeColumns.PostID, parentTable)))
+		}
+		if parentTable == models.TableNames.Users {
+			queryMods = append(queryMods, qm.Where(fmt.Sprintf("%v.%v = %v.id", models.TableNames.PostLikes, models.PostLikeColumns.UserID, parentTable)))
+		}
+	}
+
+	return queryMods
+}
+
+func PostOrderByFieldToColumn(v graphql_models.PostOrderByField) string {
+	switch v {
+	case graphql_models.PostOrderByFieldID:
+		return models.PostColumns.ID
+	case graphql_models.PostOrderByFieldTitle:
+		return models.PostColumns.Title
+	case graphql_models.PostOrderByFieldContent:
+		return models.PostColumns.Content
+	case graphql_models.PostOrderByFieldUserID:
+		return models.PostColumns.UserID
+	case graphql_models.PostOrderByFieldOrganizationID:
+		return models.PostColumns.OrganizationID
+	case graphql_models.PostOrderByFieldCreatedAt:
+		return models.PostColumns.CreatedAt
+	case graphql_models.PostOrderByFieldDeletedAt:
+		return models.PostColumns.DeletedAt
+	}
+	return ""
+}
+
+func PostOrderByToSortColumns(orderBy []*graphql_models.PostOrderBy) []SortColumn {
+	columns := make([]SortColumn, 0, len(orderBy))
+	for _, o := range orderBy {
+		if o == nil {
+			continue
+		}
+		column := PostOrderByFieldToColumn(o.Field)
+		if column == "" {
+			continue
+		}
+		columns = append(columns, SortColumn{
+			Name:       column,
+			Descending: o.Direction == graphql_models.SortDirectionDesc,
+		})
+	}
+	return columns
+}
+
+func PostOrderByToMods(orderBy []*graphql_models.PostOrderBy) []qm.QueryMod {
+	return SortColumnsToMods(PostOrderByToSortColumns(orderBy))
+}
+
+func PostWhereSubqueryToMods(m *graphql_models.PostWhere, foreignColumn string, parentTable string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+
+	// if foreign key exist so we can filter on ID in the root table instead of subquery
+	hasForeignKeyInRoot := foreignColumn != ""
+	if hasForeignKeyInRoot {
+		queryMods = append(queryMods, IDFilterToMods(m.ID, foreignColumn)...)
+	}
+
+	subQueryMods := PostWhereToMods(m, !hasForeignKeyInRoot, parentTable)
+	if len(subQueryMods) > 0 {
+		subQuery := models.Posts(append(subQueryMods, qm.Select("1"))...)
+		queryMods = appendSubQuery(queryMods, subQuery.Query)
+	}
+	return queryMods
+}
+
+func PostWhereToMods(m *graphql_models.PostWhere, withPrimaryID bool, parentTable string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+
+	if withPrimaryID {
+		queryMods = append(queryMods, IDFilterToMods(m.ID, models.PostColumns.ID)...)
+	}
+	queryMods = append(queryMods, StringFilterToMods(m.Title, models.PostColumns.Title)...)
+	queryMods = append(queryMods, StringFilterToMods(m.Content, models.PostColumns.Content)...)
+	queryMods = append(queryMods, UserWhereSubqueryToMods(m.User, models.PostColumns.UserID, models.TableNames.Posts)...)
+	queryMods = append(queryMods, OrganizationWhereSubqueryToMods(m.Organization, models.PostColumns.OrganizationID, models.TableNames.Posts)...)
+	queryMods = append(queryMods, CommentWhereSubqueryToMods(m.Comments, "", models.TableNames.Posts)...)
+	if m.Or != nil {
+		queryMods = append(queryMods, qm.Or2(qm.Expr(PostWhereToMods(m.Or, true, "")...)))
+	}
+	if m.And != nil {
+		queryMods = append(queryMods, qm.Expr(PostWhereToMods(m.And, true, "")...))
+	}
+
+	if len(queryMods) > 0 && parentTable != "" {
+
+		if parentTable == models.TableNames.Users {
+			queryMods = append(queryMods, qm.Where(fmt.Sprintf("%v.%v = %v.id", models.TableNames.Posts, models.PostColumns.UserID, parentTable)))
+		}
+		if parentTable == models.TableNames.Organizations {
+			queryMods = append(queryMods, qm.Where(fmt.Sprintf("%v.%v = %v.id", models.TableNames.Posts, models.PostColumns.OrganizationID, parentTable)))
+		}
+	}
+
+	return queryMods
+}
+
+func SettingOrderByFieldToColumn(v graphql_models.SettingOrderByField) string {
+	switch v {
+	case graphql_models.SettingOrderByFieldKey:
+		return models.SettingColumns.Key
+	case graphql_models.SettingOrderByFieldValue:
+		return models.SettingColumns.Value
+	}
+	return ""
+}
+
+func SettingOrderByToSortColumns(orderBy []*graphql_models.SettingOrderBy) []SortColumn {
+	columns := make([]SortColumn, 0, len(orderBy))
+	for _, o := range orderBy {
+		if o == nil {
+			continue
+		}
+		column := SettingOrderByFieldToColumn(o.Field)
+		if column == "" {
+			continue
+		}
+		columns = append(columns, SortColumn{
+			Name:       column,
+			Descending: o.Direction == graphql_models.SortDirectionDesc,
+		})
+	}
+	return columns
+}
+
+func SettingOrderByToMods(orderBy []*graphql_models.SettingOrderBy) []qm.QueryMod {
+	return SortColumnsToMods(SettingOrderByToSortColumns(orderBy))
+}
+
+func TagOrderByFieldToColumn(v graphql_models.TagOrderByField) string {
+	switch v {
+	case graphql_models.TagOrderByFieldID:
+		return models.TagColumns.ID
+	case graphql_models.TagOrderByFieldName:
+		return models.TagColumns.Name
+	}
+	return ""
+}
+
+func TagOrderByToSortColumns(orderBy []*graphql_models.TagOrderBy) []SortColumn {
+	columns := make([]SortColumn, 0, len(orderBy))
+	for _, o := range orderBy {
+		if o == nil {
+			continue
+		}
+		column := TagOrderByFieldToColumn(o.Field)
+		if column == "" {
+			continue
+		}
+		columns = append(columns, SortColumn{
+			Name:       column,
+			Descending: o.Direction == graphql_models.SortDirectionDesc,
+		})
+	}
+	return columns
+}
+
+func TagOrderByToMods(orderBy []*graphql_models.TagOrderBy) []qm.QueryMod {
+	return SortColumnsToMods(TagOrderByToSortColumns(orderBy))
+}
+
+func UserOrderByFieldToColumn(v graphql_models.UserOrderByField) string {
+	switch v {
+	case graphql_models.UserOrderByFieldID:
+		return models.UserColumns.ID
+	case graphql_models.UserOrderByFieldEmail:
+		return models.UserColumns.Email
+	case graphql_models.UserOrderByFieldFirstName:
+		return models.UserColumns.FirstName
+	case graphql_models.UserOrderByFieldOrganizationID:
+		return models.UserColumns.OrganizationID
+	}
+	return ""
+}
+
+func UserOrderByToSortColumns(orderBy []*graphql_models.UserOrderBy) []SortColumn {
+	columns := make([]SortColumn, 0, len(orderBy))
+	for _, o := range orderBy {
+		if o == nil {
+			continue
+		}
+		column := UserOrderByFieldToColumn(o.Field)
+		if column == "" {
+			continue
+		}
+		columns = append(columns, SortColumn{
+			Name:       column,
+			Descending: o.Direction == graphql_models.SortDirectionDesc,
+		})
+	}
+	return columns
+}
+
+func UserOrderByToMods(orderBy []*graphql_models.UserOrderBy) []qm.QueryMod {
+	return SortColumnsToMods(UserOrderByToSortColumns(orderBy))
+}
+
+func UserWhereSubqueryToMods(m *graphql_models.UserWhere, foreignColumn string, parentTable string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+
+	// if foreign key exist so we can filter on ID in the root table instead of subquery
+	hasForeignKeyInRoot := foreignColumn != ""
+	if hasForeignKeyInRoot {
+		queryMods = append(queryMods, IDFilterToMods(m.ID, foreignColumn)...)
+	}
+
+	subQueryMods := UserWhereToMods(m, !hasForeignKeyInRoot, parentTable)
+	if len(subQueryMods) > 0 {
+		subQuery := models.Users(append(subQueryMods, qm.Select("1"))...)
+		queryMods = appendSubQuery(queryMods, subQuery.Query)
+	}
+	return queryMods
+}
+
+func UserWhereToMods(m *graphql_models.UserWhere, withPrimaryID bool, parentTable string) []qm.QueryMod {
+	if m == nil {
+		return nil
+	}
+	var queryMods []qm.QueryMod
+
+	if withPrimaryID {
+		queryMods = append(queryMods, IDFilterToMods(m.ID, models.UserColumns.ID)...)
+	}
+	queryMods = append(queryMods, StringFilterToMods(m.Email, models.UserColumns.Email)...)
+	queryMods = append(queryMods, StringFilterToMods(m.FirstName, models.UserColumns.FirstName)...)
+	queryMods = append(queryMods, OrganizationWhereSubqueryToMods(m.Organization, models.UserColumns.OrganizationID, models.TableNames.Users)...)
+	if m.Or != nil {
+		queryMods = append(queryMods, qm.Or2(qm.Expr(UserWhereToMods(m.Or, true, "")...)))
+	}
+	if m.And != nil {
+		queryMods = append(queryMods, qm.Expr(UserWhereToMods(m.And, true, "")...))
+	}
+
+	if len(queryMods) > 0 && parentTable != "" {
+
+		if parentTable == models.TableNames.Organizations {
+			queryMods = append(queryMods, qm.Where(fmt.Sprintf("%v.%v = %v.id", models.TableNames.Users, models.UserColumns.OrganizationID, parentTable)))
+		}
+	}
+
+	return queryMods
+}
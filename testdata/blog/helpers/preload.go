@@ -0,0 +1,168 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package helpers
+
+import (
+	"context"
+
+	"example.com/blog/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/web-ridge/utils-go/boilergql"
+)
+
+var TablePreloadMap = map[string]map[string]boilergql.ColumnSetting{
+	models.TableNames.Comments: {
+		"post": {
+			Name:                  models.CommentRels.Post,
+			RelationshipModelName: models.TableNames.Posts,
+			IDAvailable:           true,
+		},
+		"user": {
+			Name:                  models.CommentRels.User,
+			RelationshipModelName: models.TableNames.Users,
+			IDAvailable:           true,
+		},
+	},
+	models.TableNames.Organizations: {},
+	models.TableNames.Posts: {
+		"comments": {
+			Name:                  models.PostRels.Comments,
+			RelationshipModelName: models.TableNames.Comments,
+			IDAvailable:           false,
+		},
+		"organization": {
+			Name:                  models.PostRels.Organization,
+			RelationshipModelName: models.TableNames.Organizations,
+			IDAvailable:           true,
+		},
+		"tags": {
+			Name:                  models.PostRels.Tags,
+			RelationshipModelName: models.TableNames.Tags,
+			IDAvailable:           false,
+		},
+		"user": {
+			Name:                  models.PostRels.User,
+			RelationshipModelName: models.TableNames.Users,
+			IDAvailable:           true,
+		},
+	},
+	models.TableNames.PostLikes: {
+		"post": {
+			Name:                  models.PostLikeRels.Post,
+			RelationshipModelName: models.TableNames.Posts,
+			IDAvailable:           true,
+		},
+		"user": {
+			Name:                  models.PostLikeRels.User,
+			RelationshipModelName: models.TableNames.Users,
+			IDAvailable:           true,
+		},
+	},
+	models.TableNames.Settings: {},
+	models.TableNames.Tags:     {},
+	models.TableNames.Users: {
+		"organization": {
+			Name:                  models.UserRels.Organization,
+			RelationshipModelName: models.TableNames.Organizations,
+			IDAvailable:           true,
+		},
+	},
+}
+
+func GetCommentPreloadMods(ctx context.Context) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Comments, "")
+}
+
+func GetCommentPreloadModsWithLevel(ctx context.Context, level string) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Comments, level)
+}
+
+func GetOrganizationPreloadMods(ctx context.Context) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Organizations, "")
+}
+
+func GetOrganizationPreloadModsWithLevel(ctx context.Context, level string) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Organizations, level)
+}
+
+func GetPostPreloadMods(ctx context.Context) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Posts, "")
+}
+
+func GetPostPreloadModsWithLevel(ctx context.Context, level string) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Posts, level)
+}
+
+func GetPostLikePreloadMods(ctx context.Context) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.PostLikes, "")
+}
+
+func GetPostLikePreloadModsWithLevel(ctx context.Context, level string) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.PostLikes, level)
+}
+
+func GetSettingPreloadMods(ctx context.Context) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Settings, "")
+}
+
+func GetSettingPreloadModsWithLevel(ctx context.Context, level string) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Settings, level)
+}
+
+func GetTagPreloadMods(ctx context.Context) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Tags, "")
+}
+
+func GetTagPreloadModsWithLevel(ctx context.Context, level string) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Tags, level)
+}
+
+func GetUserPreloadMods(ctx context.Context) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Users, "")
+}
+
+func GetUserPreloadModsWithLevel(ctx context.Context, level string) (queryMods []qm.QueryMod) {
+	return boilergql.GetPreloadModsWithLevel(ctx, TablePreloadMap, models.TableNames.Users, level)
+}
+
+var CommentPayloadPreloadLevels = struct {
+	Comment string
+}{
+	Comment: "comment",
+}
+
+var PostLikePayloadPreloadLevels = struct {
+	PostLike string
+}{
+	PostLike: "postLike",
+}
+
+var PostLikesPayloadPreloadLevels = struct {
+	PostLikes string
+}{
+	PostLikes: "postLikes",
+}
+
+var PostPayloadPreloadLevels = struct {
+	Post string
+}{
+	Post: "post",
+}
+
+var PostsPayloadPreloadLevels = struct {
+	Posts string
+}{
+	Posts: "posts",
+}
+
+var SettingPayloadPreloadLevels = struct {
+	Setting string
+}{
+	Setting: "setting",
+}
+
+var TagPayloadPreloadLevels = struct {
+	Tag string
+}{
+	Tag: "tag",
+}
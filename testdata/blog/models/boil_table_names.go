@@ -0,0 +1,24 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+var TableNames = struct {
+	Comments      string
+	Organizations string
+	PostLikes     string
+	PostTags      string
+	Posts         string
+	Settings      string
+	Tags          string
+	Users         string
+}{
+	Comments:      "comments",
+	Organizations: "organizations",
+	PostLikes:     "post_likes",
+	PostTags:      "post_tags",
+	Posts:         "posts",
+	Settings:      "settings",
+	Tags:          "tags",
+	Users:         "users",
+}
@@ -0,0 +1,1212 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PostLike is an object representing the database table.
+type PostLike struct {
+	PostID uint   `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
+	UserID uint   `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Note   string `boil:"note" json:"note" toml:"note" yaml:"note"`
+
+	R *postLikeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L postLikeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PostLikeColumns = struct {
+	PostID string
+	UserID string
+	Note   string
+}{
+	PostID: "post_id",
+	UserID: "user_id",
+	Note:   "note",
+}
+
+// Generated where
+
+var PostLikeWhere = struct {
+	PostID whereHelperuint
+	UserID whereHelperuint
+	Note   whereHelperstring
+}{
+	PostID: whereHelperuint{field: "\"post_likes\".\"post_id\""},
+	UserID: whereHelperuint{field: "\"post_likes\".\"user_id\""},
+	Note:   whereHelperstring{field: "\"post_likes\".\"note\""},
+}
+
+// PostLikeRels is where relationship names are stored.
+var PostLikeRels = struct {
+	User string
+	Post string
+}{
+	User: "User",
+	Post: "Post",
+}
+
+// postLikeR is where relationships are stored.
+type postLikeR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Post *Post `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+}
+
+// NewStruct creates a new relationship struct
+func (*postLikeR) NewStruct() *postLikeR {
+	return &postLikeR{}
+}
+
+// postLikeL is where Load methods for each relationship are stored.
+type postLikeL struct{}
+
+var (
+	postLikeAllColumns            = []string{"post_id", "user_id", "note"}
+	postLikeColumnsWithoutDefault = []string{"user_id", "note"}
+	postLikeColumnsWithDefault    = []string{"post_id"}
+	postLikePrimaryKeyColumns     = []string{"post_id", "user_id"}
+)
+
+type (
+	// PostLikeSlice is an alias for a slice of pointers to PostLike.
+	// This should generally be used opposed to []PostLike.
+	PostLikeSlice []*PostLike
+	// PostLikeHook is the signature for custom PostLike hook methods
+	PostLikeHook func(context.Context, boil.ContextExecutor, *PostLike) error
+
+	postLikeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	postLikeType                 = reflect.TypeOf(&PostLike{})
+	postLikeMapping              = queries.MakeStructMapping(postLikeType)
+	postLikePrimaryKeyMapping, _ = queries.BindMapping(postLikeType, postLikeMapping, postLikePrimaryKeyColumns)
+	postLikeInsertCacheMut       sync.RWMutex
+	postLikeInsertCache          = make(map[string]insertCache)
+	postLikeUpdateCacheMut       sync.RWMutex
+	postLikeUpdateCache          = make(map[string]updateCache)
+	postLikeUpsertCacheMut       sync.RWMutex
+	postLikeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var postLikeBeforeInsertHooks []PostLikeHook
+var postLikeBeforeUpdateHooks []PostLikeHook
+var postLikeBeforeDeleteHooks []PostLikeHook
+var postLikeBeforeUpsertHooks []PostLikeHook
+
+var postLikeAfterInsertHooks []PostLikeHook
+var postLikeAfterSelectHooks []PostLikeHook
+var postLikeAfterUpdateHooks []PostLikeHook
+var postLikeAfterDeleteHooks []PostLikeHook
+var postLikeAfterUpsertHooks []PostLikeHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PostLike) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLikeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PostLike) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLikeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PostLike) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLikeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PostLike) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLikeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PostLike) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLikeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PostLike) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLikeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PostLike) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLikeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PostLike) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLikeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PostLike) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLikeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPostLikeHook registers your hook function for all future operations.
+func AddPostLikeHook(hookPoint boil.HookPoint, postLikeHook PostLikeHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		postLikeBeforeInsertHooks = append(postLikeBeforeInsertHooks, postLikeHook)
+	case boil.BeforeUpdateHook:
+		postLikeBeforeUpdateHooks = append(postLikeBeforeUpdateHooks, postLikeHook)
+	case boil.BeforeDeleteHook:
+		postLikeBeforeDeleteHooks = append(postLikeBeforeDeleteHooks, postLikeHook)
+	case boil.BeforeUpsertHook:
+		postLikeBeforeUpsertHooks = append(postLikeBeforeUpsertHooks, postLikeHook)
+	case boil.AfterInsertHook:
+		postLikeAfterInsertHooks = append(postLikeAfterInsertHooks, postLikeHook)
+	case boil.AfterSelectHook:
+		postLikeAfterSelectHooks = append(postLikeAfterSelectHooks, postLikeHook)
+	case boil.AfterUpdateHook:
+		postLikeAfterUpdateHooks = append(postLikeAfterUpdateHooks, postLikeHook)
+	case boil.AfterDeleteHook:
+		postLikeAfterDeleteHooks = append(postLikeAfterDeleteHooks, postLikeHook)
+	case boil.AfterUpsertHook:
+		postLikeAfterUpsertHooks = append(postLikeAfterUpsertHooks, postLikeHook)
+	}
+}
+
+// One returns a single postLike record from the query.
+func (q postLikeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PostLike, error) {
+	o := &PostLike{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for post_likes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PostLike records from the query.
+func (q postLikeQuery) All(ctx context.Context, exec boil.ContextExecutor) (PostLikeSlice, error) {
+	var o []*PostLike
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PostLike slice")
+	}
+
+	if len(postLikeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PostLike records in the query.
+func (q postLikeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count post_likes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q postLikeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if post_likes exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *PostLike) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Post pointed to by the foreign key.
+func (o *PostLike) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PostID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Posts(queryMods...)
+	queries.SetFrom(query.Query, "\"posts\"")
+
+	return query
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postLikeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostLike interface{}, mods queries.Applicator) error {
+	var slice []*PostLike
+	var object *PostLike
+
+	if singular {
+		object = maybePostLike.(*PostLike)
+	} else {
+		slice = *maybePostLike.(*[]*PostLike)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postLikeR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postLikeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(postLikeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PostLikes = append(foreign.R.PostLikes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PostLikes = append(foreign.R.PostLikes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postLikeL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostLike interface{}, mods queries.Applicator) error {
+	var slice []*PostLike
+	var object *PostLike
+
+	if singular {
+		object = maybePostLike.(*PostLike)
+	} else {
+		slice = *maybePostLike.(*[]*PostLike)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postLikeR{}
+		}
+		args = append(args, object.PostID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postLikeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PostID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PostID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.id in ?`, args...),
+		qmhelper.WhereIsNull(`posts.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postLikeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.PostLikes = append(foreign.R.PostLikes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PostID == foreign.ID {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.PostLikes = append(foreign.R.PostLikes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the postLike to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PostLikes.
+func (o *PostLike) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"post_likes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, postLikePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.PostID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &postLikeR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PostLikes: PostLikeSlice{o},
+		}
+	} else {
+		related.R.PostLikes = append(related.R.PostLikes, o)
+	}
+
+	return nil
+}
+
+// SetPost of the postLike to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.PostLikes.
+func (o *PostLike) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"post_likes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"post_id"}),
+		strmangle.WhereClause("\"", "\"", 0, postLikePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.PostID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.ID
+	if o.R == nil {
+		o.R = &postLikeR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			PostLikes: PostLikeSlice{o},
+		}
+	} else {
+		related.R.PostLikes = append(related.R.PostLikes, o)
+	}
+
+	return nil
+}
+
+// PostLikes retrieves all the records using an executor.
+func PostLikes(mods ...qm.QueryMod) postLikeQuery {
+	mods = append(mods, qm.From("\"post_likes\""))
+	return postLikeQuery{NewQuery(mods...)}
+}
+
+// FindPostLike retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPostLike(ctx context.Context, exec boil.ContextExecutor, postID uint, userID uint, selectCols ...string) (*PostLike, error) {
+	postLikeObj := &PostLike{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"post_likes\" where \"post_id\"=? AND \"user_id\"=?", sel,
+	)
+
+	q := queries.Raw(query, postID, userID)
+
+	err := q.Bind(ctx, exec, postLikeObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from post_likes")
+	}
+
+	return postLikeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PostLike) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no post_likes provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(postLikeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	postLikeInsertCacheMut.RLock()
+	cache, cached := postLikeInsertCache[key]
+	postLikeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			postLikeAllColumns,
+			postLikeColumnsWithDefault,
+			postLikeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(postLikeType, postLikeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(postLikeType, postLikeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"post_likes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"post_likes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into post_likes")
+	}
+
+	if !cached {
+		postLikeInsertCacheMut.Lock()
+		postLikeInsertCache[key] = cache
+		postLikeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PostLike.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PostLike) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	postLikeUpdateCacheMut.RLock()
+	cache, cached := postLikeUpdateCache[key]
+	postLikeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			postLikeAllColumns,
+			postLikePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update post_likes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"post_likes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, postLikePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(postLikeType, postLikeMapping, append(wl, postLikePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update post_likes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for post_likes")
+	}
+
+	if !cached {
+		postLikeUpdateCacheMut.Lock()
+		postLikeUpdateCache[key] = cache
+		postLikeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q postLikeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for post_likes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for post_likes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PostLikeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postLikePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"post_likes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, postLikePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in postLike slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all postLike")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PostLike) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no post_likes provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(postLikeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	postLikeUpsertCacheMut.RLock()
+	cache, cached := postLikeUpsertCache[key]
+	postLikeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			postLikeAllColumns,
+			postLikeColumnsWithDefault,
+			postLikeColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			postLikeAllColumns,
+			postLikePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert post_likes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(postLikePrimaryKeyColumns))
+			copy(conflict, postLikePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"post_likes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(postLikeType, postLikeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(postLikeType, postLikeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert post_likes")
+	}
+
+	if !cached {
+		postLikeUpsertCacheMut.Lock()
+		postLikeUpsertCache[key] = cache
+		postLikeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PostLike record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PostLike) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PostLike provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), postLikePrimaryKeyMapping)
+	sql := "DELETE FROM \"post_likes\" WHERE \"post_id\"=? AND \"user_id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from post_likes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for post_likes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q postLikeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no postLikeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from post_likes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for post_likes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PostLikeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(postLikeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postLikePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"post_likes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, postLikePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from postLike slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for post_likes")
+	}
+
+	if len(postLikeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PostLike) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPostLike(ctx, exec, o.PostID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PostLikeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PostLikeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postLikePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"post_likes\".* FROM \"post_likes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, postLikePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PostLikeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PostLikeExists checks if the PostLike row exists.
+func PostLikeExists(ctx context.Context, exec boil.ContextExecutor, postID uint, userID uint) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"post_likes\" where \"post_id\"=? AND \"user_id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, postID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, postID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if post_likes exists")
+	}
+
+	return exists, nil
+}
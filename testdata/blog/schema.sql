@@ -0,0 +1,8 @@
+CREATE TABLE organizations (id INTEGER PRIMARY KEY NOT NULL, name VARCHAR NOT NULL);
+CREATE TABLE users (id INTEGER PRIMARY KEY NOT NULL, email VARCHAR NOT NULL UNIQUE, first_name VARCHAR NOT NULL, organization_id INTEGER NOT NULL REFERENCES organizations(id));
+CREATE TABLE posts (id INTEGER PRIMARY KEY NOT NULL, title VARCHAR NOT NULL, content VARCHAR NOT NULL, user_id INTEGER NOT NULL REFERENCES users(id), organization_id INTEGER NOT NULL REFERENCES organizations(id), created_at DATETIME, deleted_at DATETIME);
+CREATE TABLE comments (id INTEGER PRIMARY KEY NOT NULL, content VARCHAR NOT NULL, post_id INTEGER NOT NULL REFERENCES posts(id), user_id INTEGER NOT NULL REFERENCES users(id));
+CREATE TABLE tags (id INTEGER PRIMARY KEY NOT NULL, name VARCHAR NOT NULL);
+CREATE TABLE post_tags (post_id INTEGER NOT NULL REFERENCES posts(id), tag_id INTEGER NOT NULL REFERENCES tags(id), PRIMARY KEY (post_id, tag_id));
+CREATE TABLE post_likes (post_id INTEGER NOT NULL REFERENCES posts(id), user_id INTEGER NOT NULL REFERENCES users(id), note VARCHAR NOT NULL, PRIMARY KEY (post_id, user_id));
+CREATE TABLE settings (key VARCHAR PRIMARY KEY NOT NULL, value VARCHAR NOT NULL);
@@ -0,0 +1,55 @@
+// Package server creates the resolvers of testdata/blog like an application would, TestBlogCompiles checks that the
+// generated code still fits it
+package server
+
+import (
+	"context"
+	"database/sql"
+
+	"example.com/blog/graph"
+	"example.com/blog/helpers"
+)
+
+// NewResolver uses the database for every query and starts a transaction for every mutation
+func NewResolver(db *sql.DB) *graph.Resolver {
+	return graph.NewResolver(helpers.SQLDB(db))
+}
+
+// NewRequestResolver runs the queries and mutations of a request in the transaction of the request, committing it is
+// up to the caller
+func NewRequestResolver(tx *sql.Tx) *graph.Resolver {
+	return graph.NewResolver(helpers.SQLTx(tx))
+}
+
+// countingDB is a database with instrumentation, it counts the queries of the resolvers
+type countingDB struct {
+	helpers.DB
+	queries int
+}
+
+func (db *countingDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	db.queries++
+	return db.DB.QueryContext(ctx, query, args...)
+}
+
+func (db *countingDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	db.queries++
+	return db.DB.QueryRowContext(ctx, query, args...)
+}
+
+// NewCountingResolver returns the resolvers together with a function which returns how many queries they did
+func NewCountingResolver(db *sql.DB) (*graph.Resolver, func() int) {
+	counting := &countingDB{DB: helpers.SQLDB(db)}
+	return graph.NewResolver(counting), func() int { return counting.queries }
+}
+
+// NewReplicaResolver lets the queries use the read replica, mutations and the payloads they return use the writer
+func NewReplicaResolver(writer, replica *sql.DB) *graph.Resolver {
+	return graph.NewResolver(helpers.SQLDB(writer), graph.WithReader(replica))
+}
+
+// ReadAfterWrite lets the queries of the context use the writer, the read replica might not have the changes of a
+// mutation yet
+func ReadAfterWrite(ctx context.Context) context.Context {
+	return helpers.WithPrimary(ctx)
+}
@@ -0,0 +1,115 @@
+package gqlgen_sqlboiler
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// validationDirectives are checked by the generated e.g. PostCreateInputValidate functions instead of at runtime by
+// gqlgen, the schema has to declare them:
+//
+//	directive @constraint(minLength: Int, maxLength: Int, pattern: String) on INPUT_FIELD_DEFINITION
+//	directive @email on INPUT_FIELD_DEFINITION
+//	directive @range(min: Float, max: Float) on INPUT_FIELD_DEFINITION
+var validationDirectives = []string{"constraint", "email", "range"} //nolint:gochecknoglobals
+
+// Validation of an input field by its directives e.g. title: String! @constraint(minLength: 3, maxLength: 120)
+type Validation struct {
+	// MinLength and MaxLength count the characters of a string, they are 0 when not set
+	MinLength int
+	MaxLength int
+	// Pattern is a regular expression which the string has to match
+	Pattern string
+	IsEmail bool
+	// Min and Max are Go literals e.g. 1.5, they are empty when not set
+	Min string
+	Max string
+	// IsPointer is true when the field is optional so the value is only validated when it is set
+	IsPointer bool
+}
+
+// skipValidationDirectivesAtRuntime tells gqlgen that the validation directives of the schema do not need an
+// implementation
+func skipValidationDirectivesAtRuntime(cfg *config.Config) {
+	for _, name := range validationDirectives {
+		if _, ok := cfg.Schema.Directives[name]; !ok {
+			continue
+		}
+		if _, ok := cfg.Directives[name]; !ok {
+			cfg.Directives[name] = config.DirectiveConfig{SkipRuntime: true}
+		}
+	}
+}
+
+// getValidation returns the validation of the field by its directives, nil if it has none
+func getValidation(field *ast.FieldDefinition, goType string) (*Validation, error) {
+	constraint := field.Directives.ForName("constraint")
+	email := field.Directives.ForName("email")
+	valueRange := field.Directives.ForName("range")
+	if constraint == nil && email == nil && valueRange == nil {
+		return nil, nil
+	}
+
+	v := &Validation{IsEmail: email != nil, IsPointer: goType[0] == '*'}
+	typeName := field.Type.Name()
+	if (constraint != nil || email != nil) && (field.Type.Elem != nil || (typeName != "String" && typeName != "ID")) {
+		return nil, errors.New("@constraint and @email are only supported on String and ID fields")
+	}
+	if valueRange != nil && (field.Type.Elem != nil || (typeName != "Int" && typeName != "Float")) {
+		return nil, errors.New("@range is only supported on Int and Float fields")
+	}
+
+	var err error
+	if constraint != nil {
+		if v.MinLength, err = intArgument(constraint, "minLength"); err != nil {
+			return nil, err
+		}
+		if v.MaxLength, err = intArgument(constraint, "maxLength"); err != nil {
+			return nil, err
+		}
+		if argument := constraint.Arguments.ForName("pattern"); argument != nil {
+			v.Pattern = argument.Value.Raw
+			if _, err := regexp.Compile(v.Pattern); err != nil {
+				return nil, fmt.Errorf("pattern of @constraint is not valid: %w", err)
+			}
+		}
+	}
+	if valueRange != nil {
+		if v.Min, err = floatArgument(valueRange, "min"); err != nil {
+			return nil, err
+		}
+		if v.Max, err = floatArgument(valueRange, "max"); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func intArgument(directive *ast.Directive, name string) (int, error) {
+	argument := directive.Arguments.ForName(name)
+	if argument == nil {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(argument.Value.Raw)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("%v of @%v should be a positive Int", name, directive.Name)
+	}
+	return value, nil
+}
+
+func floatArgument(directive *ast.Directive, name string) (string, error) {
+	argument := directive.Arguments.ForName(name)
+	if argument == nil {
+		return "", nil
+	}
+	value, err := strconv.ParseFloat(argument.Value.Raw, 64)
+	if err != nil {
+		return "", fmt.Errorf("%v of @%v should be a Float", name, directive.Name)
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64), nil
+}
@@ -0,0 +1,53 @@
+package gqlgen_sqlboiler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestGetValidation(t *testing.T) {
+	schema, err := gqlparser.LoadSchema(&ast.Source{Input: `
+		directive @constraint(minLength: Int, maxLength: Int, pattern: String) on INPUT_FIELD_DEFINITION
+		directive @email on INPUT_FIELD_DEFINITION
+		directive @range(min: Float, max: Float) on INPUT_FIELD_DEFINITION
+		type Query { ok: Boolean }
+		input PostCreateInput {
+			title: String! @constraint(minLength: 3, maxLength: 120, pattern: "^[A-Z]")
+			email: String @email
+			likes: Int @range(min: 0, max: 1.5e3)
+			content: String!
+			rating: Int @constraint(maxLength: 3)
+			slug: String @constraint(pattern: "[")
+		}
+	`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := schema.Types["PostCreateInput"]
+
+	tests := []struct {
+		field    string
+		goType   string
+		expected *Validation
+		hasError bool
+	}{
+		{"title", "string", &Validation{MinLength: 3, MaxLength: 120, Pattern: "^[A-Z]"}, false},
+		{"email", "*string", &Validation{IsEmail: true, IsPointer: true}, false},
+		{"likes", "*int", &Validation{Min: "0", Max: "1500", IsPointer: true}, false},
+		{"content", "string", nil, false},
+		{"rating", "*int", nil, true},
+		{"slug", "*string", nil, true},
+	}
+	for _, test := range tests {
+		validation, err := getValidation(input.Fields.ForName(test.field), test.goType)
+		if (err != nil) != test.hasError {
+			t.Errorf("%v expected error %v but got %v", test.field, test.hasError, err)
+		}
+		if !reflect.DeepEqual(validation, test.expected) {
+			t.Errorf("%v expected %+v but got %+v", test.field, test.expected, validation)
+		}
+	}
+}